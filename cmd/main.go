@@ -1,3 +1,26 @@
 package main
 
-func main() {}
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/app"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/config"
+)
+
+func main() {
+	cfg, err := config.Load(os.Args[1:], os.Getenv)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	if err := app.Run(ctx, cfg); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -5,6 +5,12 @@ go 1.21.3
 require (
     go.mongodb.org/mongo-driver v1.12.1
     github.com/Bit-Bridge-Source/BitBridge-CommonService-Go v1.10.4
+    google.golang.org/genproto/googleapis/rpc v0.0.0-20231002182017-d307bd883b97
+    google.golang.org/grpc v1.59.0
+    google.golang.org/protobuf v1.31.0
+    gopkg.in/yaml.v3 v3.0.1
+    golang.org/x/text v0.13.0
+    github.com/golang-jwt/jwt/v5 v5.0.0
 )
 
 replace github.com/Bit-Bridge-Source/BitBridge-CommonService-Go => ../common-service
\ No newline at end of file
@@ -0,0 +1,229 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/auth"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/authz"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/config"
+	repogrpc "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/grpc"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	rest "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/adapter"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/handler"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/router"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/server"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public/proto/repov1"
+	"github.com/gofiber/fiber/v2"
+	"google.golang.org/grpc"
+)
+
+type App struct {
+	Config        *config.Config
+	Service       service.RepoService
+	Collaborators service.CollaboratorService
+	Organizations service.OrganizationService
+	Forks         service.ForkService
+	Trash         service.TrashService
+	Subscriptions service.SubscriptionService
+	Counts        service.CountService
+	Fiber         *fiber.App
+	GRPC          *grpc.Server
+}
+
+// Stores are the storage backends the app runs against
+type Stores struct {
+	Repos         repository.RepoRepository
+	Audit         repository.AuditRepository
+	Collaborators repository.CollaboratorRepository
+	Organizations repository.OrganizationRepository
+	Teams         repository.TeamRepository
+	Redirects     repository.RedirectRepository
+	Subscriptions repository.SubscriptionRepository
+}
+
+// MemoryStores keeps everything in process memory
+func MemoryStores() Stores {
+	return Stores{
+		Repos:         repository.NewMemoryRepoRepository(),
+		Audit:         repository.NewMemoryAuditRepository(),
+		Collaborators: repository.NewMemoryCollaboratorRepository(),
+		Organizations: repository.NewMemoryOrganizationRepository(),
+		Teams:         repository.NewMemoryTeamRepository(),
+		Redirects:     repository.NewMemoryRedirectRepository(),
+		Subscriptions: repository.NewMemorySubscriptionRepository(),
+	}
+}
+
+// New wires the app, it fails when the configured auth keys cannot be loaded
+func New(cfg *config.Config, stores Stores) (*App, error) {
+	authenticator, err := auth.NewAuthenticator(cfg.Auth)
+	if err != nil {
+		return nil, fmt.Errorf("load auth keys: %w", err)
+	}
+
+	fiberApp := fiber.New(fiber.Config{
+		ReadTimeout:           time.Duration(cfg.HTTP.ReadTimeout),
+		WriteTimeout:          time.Duration(cfg.HTTP.WriteTimeout),
+		IdleTimeout:           time.Duration(cfg.HTTP.IdleTimeout),
+		DisableStartupMessage: true,
+	})
+	fiberApp.Use(rest.Authenticate(authenticator))
+
+	repoService := service.NewRepoService(stores.Repos)
+	repoService.Audit = stores.Audit
+	repoService.Collaborators = stores.Collaborators
+	repoService.Organizations = stores.Organizations
+	repoService.Teams = stores.Teams
+	repoService.Redirects = stores.Redirects
+	repoService.Subscriptions = stores.Subscriptions
+	repoService.Authorizer = authz.NewRolePolicy(stores.Collaborators, stores.Organizations, stores.Teams)
+	repoService.Policy = &cfg.Names
+	repoService.TrashRetention = time.Duration(cfg.Trash.Retention)
+
+	app := &App{
+		Config:        cfg,
+		Service:       repoService,
+		Collaborators: repoService,
+		Organizations: repoService,
+		Forks:         repoService,
+		Trash:         repoService,
+		Subscriptions: repoService,
+		Counts:        repoService,
+		Fiber:         fiberApp,
+		GRPC:          grpc.NewServer(grpc.UnaryInterceptor(repogrpc.AuthInterceptor(authenticator))),
+	}
+	app.registerRoutes(&rest.FiberRouterAdapter{App: fiberApp})
+	repov1.RegisterRepoServiceServer(app.GRPC, repogrpc.NewRepoServer(app.Service))
+	repov1.RegisterCollaboratorServiceServer(app.GRPC, repogrpc.NewCollaboratorServer(app.Collaborators))
+	repov1.RegisterOrganizationServiceServer(app.GRPC, repogrpc.NewOrganizationServer(app.Organizations))
+	repov1.RegisterForkServiceServer(app.GRPC, repogrpc.NewForkServer(app.Forks))
+	repov1.RegisterSubscriptionServiceServer(app.GRPC, repogrpc.NewSubscriptionServer(app.Subscriptions))
+	repov1.RegisterCountServiceServer(app.GRPC, repogrpc.NewCountServer(app.Counts))
+
+	return app, nil
+}
+
+func (a *App) registerRoutes(r router.Router) {
+	r.GET("/health", func(ctx server.HTTPContext) {
+		ctx.JSON(http.StatusOK, map[string]string{"status": "ok"})
+	})
+
+	handler.NewCollaboratorHandler(a.Collaborators).Register(r)
+	handler.NewOrganizationHandler(a.Organizations).Register(r)
+	handler.NewForkHandler(a.Forks).Register(r)
+	handler.NewSubscriptionHandler(a.Subscriptions).Register(r)
+	handler.NewCountHandler(a.Counts).Register(r)
+	handler.NewRepoHandler(a.Service).Register(r)
+}
+
+func (a *App) ListenAndServe(ctx context.Context) error {
+	httpLn, err := net.Listen("tcp", a.Config.HTTP.Addr)
+	if err != nil {
+		return err
+	}
+
+	grpcLn, err := net.Listen("tcp", a.Config.GRPC.Addr)
+	if err != nil {
+		httpLn.Close()
+		return err
+	}
+
+	return a.Serve(ctx, httpLn, grpcLn)
+}
+
+// Serve accepts REST and gRPC connections until ctx is cancelled or either server
+// fails, then stops accepting new requests and waits up to ShutdownTimeout for
+// in-flight ones to finish. The trash is purged in the background meanwhile.
+func (a *App) Serve(ctx context.Context, httpLn, grpcLn net.Listener) error {
+	purgeCtx, stopPurging := context.WithCancel(ctx)
+	purged := make(chan struct{})
+	go func() {
+		a.RunPurger(purgeCtx)
+		close(purged)
+	}()
+	defer func() {
+		stopPurging()
+		<-purged
+	}()
+
+	serveErrs := make(chan error, 2)
+	go func() {
+		serveErrs <- a.Fiber.Listener(httpLn)
+	}()
+	go func() {
+		serveErrs <- a.GRPC.Serve(grpcLn)
+	}()
+
+	var err error
+	running := 2
+
+	select {
+	case err = <-serveErrs:
+		running--
+	case <-ctx.Done():
+	}
+
+	if shutdownErr := a.shutdown(); err == nil {
+		err = shutdownErr
+	}
+
+	for ; running > 0; running-- {
+		if serveErr := <-serveErrs; err == nil {
+			err = serveErr
+		}
+	}
+
+	return err
+}
+
+// RunPurger purges expired repos from the trash every PurgeInterval until ctx is
+// cancelled. Failures are logged and retried on the next tick.
+func (a *App) RunPurger(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(a.Config.Trash.PurgeInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			purged, err := a.Trash.PurgeTrash(ctx, now)
+			if err != nil {
+				log.Printf("purge trash: %v", err)
+			}
+			if purged > 0 {
+				log.Printf("purged %d repos from the trash", purged)
+			}
+		}
+	}
+}
+
+func (a *App) shutdown() error {
+	deadline := time.Now().Add(time.Duration(a.Config.ShutdownTimeout))
+
+	grpcStopped := make(chan struct{})
+	go func() {
+		a.GRPC.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	err := a.Fiber.ShutdownWithTimeout(time.Until(deadline))
+
+	select {
+	case <-grpcStopped:
+	case <-time.After(time.Until(deadline)):
+		a.GRPC.Stop()
+	}
+
+	if err != nil {
+		return fmt.Errorf("shutdown: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package app_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/app"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/config"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func startApp(t *testing.T, application *app.App) (string, context.CancelFunc, chan error) {
+	httpLn, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	grpcLn, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- application.Serve(ctx, httpLn, grpcLn)
+	}()
+
+	return "http://" + httpLn.Addr().String(), cancel, done
+}
+
+func TestServe_Health(t *testing.T) {
+	cfg := config.Default()
+	application, err := app.New(cfg, app.MemoryStores())
+	assert.Nil(t, err)
+
+	baseURL, cancel, done := startApp(t, application)
+
+	resp, err := http.Get(baseURL + "/health")
+	assert.Nil(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.JSONEq(t, `{"status":"ok"}`, string(body))
+
+	cancel()
+	assert.Nil(t, <-done)
+}
+
+func TestServe_DrainsInFlightRequests(t *testing.T) {
+	cfg := config.Default()
+	cfg.ShutdownTimeout = config.Duration(5 * time.Second)
+	application, err := app.New(cfg, app.MemoryStores())
+	assert.Nil(t, err)
+
+	started := make(chan struct{})
+	application.Fiber.Get("/slow", func(ctx *fiber.Ctx) error {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		return ctx.SendString("done")
+	})
+
+	baseURL, cancel, done := startApp(t, application)
+
+	result := make(chan string, 1)
+	go func() {
+		resp, err := http.Get(baseURL + "/slow")
+		if err != nil {
+			result <- err.Error()
+			return
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		result <- string(body)
+	}()
+
+	<-started
+	cancel()
+
+	assert.Equal(t, "done", <-result)
+	assert.Nil(t, <-done)
+}
+
+func TestServe_RepoLifecycle(t *testing.T) {
+	cfg := config.Default()
+	cfg.Auth.HMACSecret = strings.Repeat("s", config.MinHMACSecretLength)
+	application, err := app.New(cfg, app.MemoryStores())
+	assert.Nil(t, err)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   "owner",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}).SignedString([]byte(cfg.Auth.HMACSecret))
+	assert.Nil(t, err)
+
+	baseURL, cancel, done := startApp(t, application)
+	defer func() {
+		cancel()
+		assert.Nil(t, <-done)
+	}()
+
+	resp, err := http.Post(baseURL+"/repos", "application/json", strings.NewReader(`{"name": "My Repo"}`))
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	req, _ := http.NewRequest(http.MethodPost, baseURL+"/repos", strings.NewReader(`{"name": "My Repo", "description": "test", "ownerId": "someone-else"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	created := map[string]interface{}{}
+	json.NewDecoder(resp.Body).Decode(&created)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "my-repo", created["name"])
+	assert.Equal(t, "owner", created["ownerId"])
+
+	resp, err = http.Get(baseURL + "/repos/my-repo")
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	req, _ = http.NewRequest(http.MethodPatch, baseURL+"/repos/"+created["id"].(string), strings.NewReader(`{"description": "patched"}`))
+	req.Header.Set("Content-Type", "application/merge-patch+json")
+	req.Header.Set("If-Match", `"1"`)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, `"2"`, resp.Header.Get("ETag"))
+
+	req, _ = http.NewRequest(http.MethodDelete, baseURL+"/repos/"+created["id"].(string), nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, err = http.Get(baseURL + "/repos/my-repo")
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	req, _ = http.NewRequest(http.MethodPost, baseURL+"/repos/"+created["id"].(string)+"/restore", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, err = http.Get(baseURL + "/repos/my-repo")
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	req, _ = http.NewRequest(http.MethodGet, baseURL+"/repos", nil)
+	req.Header.Set("Authorization", "Bearer "+token+"x")
+	resp, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("WWW-Authenticate"), "Bearer")
+}
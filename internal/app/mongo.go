@@ -0,0 +1,28 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/config"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+)
+
+func ConnectMongo(ctx context.Context, cfg config.MongoConfig) (*mongo.Client, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(cfg.ConnectTimeout))
+	defer cancel()
+
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(cfg.URI))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.Ping(ctx, readpref.Primary()); err != nil {
+		client.Disconnect(context.Background())
+		return nil, err
+	}
+
+	return client, nil
+}
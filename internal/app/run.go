@@ -0,0 +1,99 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/config"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+)
+
+// Run wires the service against the configured storage backend and serves until
+// ctx is cancelled
+func Run(ctx context.Context, cfg *config.Config) error {
+	stores, closeStores, err := openStores(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer closeStores()
+
+	app, err := New(cfg, stores)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("repo service listening on %s (REST) and %s (gRPC), storage: %s", cfg.HTTP.Addr, cfg.GRPC.Addr, cfg.Storage.Backend)
+	return app.ListenAndServe(ctx)
+}
+
+func openStores(ctx context.Context, cfg *config.Config) (Stores, func(), error) {
+	if cfg.Storage.Backend == config.BackendMemory {
+		return MemoryStores(), func() {}, nil
+	}
+
+	client, err := ConnectMongo(ctx, cfg.Mongo)
+	if err != nil {
+		return Stores{}, nil, fmt.Errorf("connect mongo: %w", err)
+	}
+
+	closeClient := func() {
+		disconnectCtx, cancel := context.WithTimeout(context.Background(), time.Duration(cfg.ShutdownTimeout))
+		defer cancel()
+
+		if err := client.Disconnect(disconnectCtx); err != nil {
+			log.Printf("disconnect mongo: %v", err)
+		}
+	}
+
+	database := client.Database(cfg.Mongo.Database)
+	repoRepository := repository.NewRepoRepository(database.Collection(cfg.Mongo.Collection))
+
+	if err := repoRepository.EnsureIndexes(ctx); err != nil {
+		closeClient()
+		return Stores{}, nil, fmt.Errorf("ensure indexes: %w", err)
+	}
+
+	collaborators := repository.NewCollaboratorRepository(database.Collection(cfg.Mongo.CollaboratorCollection))
+	if err := collaborators.EnsureIndexes(ctx); err != nil {
+		closeClient()
+		return Stores{}, nil, fmt.Errorf("ensure collaborator indexes: %w", err)
+	}
+
+	organizations := repository.NewOrganizationRepository(database.Collection(cfg.Mongo.OrganizationCollection))
+	if err := organizations.EnsureIndexes(ctx); err != nil {
+		closeClient()
+		return Stores{}, nil, fmt.Errorf("ensure organization indexes: %w", err)
+	}
+
+	teams := repository.NewTeamRepository(database.Collection(cfg.Mongo.TeamCollection))
+	if err := teams.EnsureIndexes(ctx); err != nil {
+		closeClient()
+		return Stores{}, nil, fmt.Errorf("ensure team indexes: %w", err)
+	}
+
+	redirects := repository.NewRedirectRepository(database.Collection(cfg.Mongo.RedirectCollection))
+	if err := redirects.EnsureIndexes(ctx); err != nil {
+		closeClient()
+		return Stores{}, nil, fmt.Errorf("ensure redirect indexes: %w", err)
+	}
+
+	subscriptions := repository.NewSubscriptionRepository(database.Collection(cfg.Mongo.SubscriptionCollection))
+	if err := subscriptions.EnsureIndexes(ctx); err != nil {
+		closeClient()
+		return Stores{}, nil, fmt.Errorf("ensure subscription indexes: %w", err)
+	}
+
+	stores := Stores{
+		Repos:         repoRepository,
+		Audit:         repository.NewAuditRepository(database.Collection(cfg.Mongo.AuditCollection)),
+		Collaborators: collaborators,
+		Organizations: organizations,
+		Teams:         teams,
+		Redirects:     redirects,
+		Subscriptions: subscriptions,
+	}
+
+	return stores, closeClient, nil
+}
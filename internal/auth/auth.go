@@ -0,0 +1,127 @@
+// Package auth validates the bearer JWTs sent by callers. HS256 tokens are checked
+// against a shared secret, RS256 and ES256 tokens against the keys of a local JWKS
+// file picked by the kid header.
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/config"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Leeway is the clock skew tolerated on exp, nbf and iat
+const Leeway = 30 * time.Second
+
+// tokenClaims are the registered claims plus the caller's platform roles
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles,omitempty"`
+}
+
+type Authenticator struct {
+	hmacSecret []byte
+	keys       map[string]crypto.PublicKey // JWKS keys by kid
+	parser     *jwt.Parser
+}
+
+func NewAuthenticator(cfg config.AuthConfig) (*Authenticator, error) {
+	authenticator := &Authenticator{
+		hmacSecret: []byte(cfg.HMACSecret),
+		keys:       map[string]crypto.PublicKey{},
+	}
+
+	if cfg.JWKSFile != "" {
+		keys, err := loadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		authenticator.keys = keys
+	}
+
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"HS256", "RS256", "ES256"}),
+		jwt.WithLeeway(Leeway),
+	}
+	if cfg.Issuer != "" {
+		options = append(options, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		options = append(options, jwt.WithAudience(cfg.Audience))
+	}
+	authenticator.parser = jwt.NewParser(options...)
+
+	return authenticator, nil
+}
+
+// Authenticate validates a raw token and returns the caller named by its sub claim,
+// holding the roles of its roles claim. Tokens must expire.
+func (a *Authenticator) Authenticate(token string) (identity.Caller, error) {
+	claims := &tokenClaims{}
+	if _, err := a.parser.ParseWithClaims(token, claims, a.key); err != nil {
+		return identity.Caller{}, repoerr.Unauthenticated("invalid token: %v", err)
+	}
+
+	if claims.ExpiresAt == nil {
+		return identity.Caller{}, repoerr.Unauthenticated("invalid token: exp claim is required")
+	}
+	if claims.Subject == "" {
+		return identity.Caller{}, repoerr.Unauthenticated("invalid token: sub claim is required")
+	}
+
+	return identity.Caller{ID: claims.Subject, Roles: claims.Roles}, nil
+}
+
+// AuthenticateHeader reads an Authorization header. No header means an anonymous
+// caller and returns false, anything but a valid bearer token is an error.
+func (a *Authenticator) AuthenticateHeader(header string) (identity.Caller, bool, error) {
+	if header == "" {
+		return identity.Caller{}, false, nil
+	}
+
+	scheme, token, _ := strings.Cut(header, " ")
+	if !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return identity.Caller{}, false, repoerr.Unauthenticated("authorization must be a bearer token")
+	}
+
+	caller, err := a.Authenticate(strings.TrimSpace(token))
+	if err != nil {
+		return identity.Caller{}, false, err
+	}
+
+	return caller, true, nil
+}
+
+// key picks the verification key for the token's algorithm
+func (a *Authenticator) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.Alg() {
+	case "HS256":
+		if len(a.hmacSecret) == 0 {
+			return nil, fmt.Errorf("HS256 tokens are not accepted")
+		}
+		return a.hmacSecret, nil
+	case "RS256":
+		if key, ok := a.jwk(token).(*rsa.PublicKey); ok {
+			return key, nil
+		}
+	case "ES256":
+		if key, ok := a.jwk(token).(*ecdsa.PublicKey); ok && key.Curve == elliptic.P256() {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no %s key for kid %q", token.Method.Alg(), token.Header["kid"])
+}
+
+func (a *Authenticator) jwk(token *jwt.Token) crypto.PublicKey {
+	kid, _ := token.Header["kid"].(string)
+	return a.keys[kid]
+}
@@ -0,0 +1,188 @@
+package auth_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/auth"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/config"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+var secret = strings.Repeat("s", config.MinHMACSecretLength)
+
+func claims(subject string) jwt.RegisteredClaims {
+	return jwt.RegisteredClaims{
+		Subject:   subject,
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, claims jwt.Claims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	signed, err := token.SignedString(key)
+	assert.Nil(t, err)
+	return signed
+}
+
+func encodeInt(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func writeJWKS(t *testing.T, rsaKey *rsa.PrivateKey, ecKey *ecdsa.PrivateKey) string {
+	keys := map[string]interface{}{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "rsa-1", "use": "sig", "n": encodeInt(rsaKey.N), "e": encodeInt(big.NewInt(int64(rsaKey.E)))},
+		{"kty": "EC", "kid": "ec-1", "crv": "P-256", "x": encodeInt(ecKey.X), "y": encodeInt(ecKey.Y)},
+		{"kty": "RSA", "kid": "enc-1", "use": "enc"},
+	}}
+	data, err := json.Marshal(keys)
+	assert.Nil(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	assert.Nil(t, os.WriteFile(path, data, 0o600))
+	return path
+}
+
+func TestAuthenticate_HS256(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(config.AuthConfig{HMACSecret: secret})
+	assert.Nil(t, err)
+
+	caller, err := authenticator.Authenticate(sign(t, jwt.SigningMethodHS256, "", []byte(secret), claims("user-1")))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "user-1", caller.ID)
+}
+
+func TestAuthenticate_Roles(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(config.AuthConfig{HMACSecret: secret})
+	assert.Nil(t, err)
+
+	token := sign(t, jwt.SigningMethodHS256, "", []byte(secret), jwt.MapClaims{
+		"sub":   "user-1",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": []string{"admin"},
+	})
+	caller, err := authenticator.Authenticate(token)
+
+	assert.Nil(t, err)
+	assert.True(t, caller.HasRole("admin"))
+}
+
+func TestAuthenticate_JWKS(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	authenticator, err := auth.NewAuthenticator(config.AuthConfig{JWKSFile: writeJWKS(t, rsaKey, ecKey)})
+	assert.Nil(t, err)
+
+	caller, err := authenticator.Authenticate(sign(t, jwt.SigningMethodRS256, "rsa-1", rsaKey, claims("user-rsa")))
+	assert.Nil(t, err)
+	assert.Equal(t, "user-rsa", caller.ID)
+
+	caller, err = authenticator.Authenticate(sign(t, jwt.SigningMethodES256, "ec-1", ecKey, claims("user-ec")))
+	assert.Nil(t, err)
+	assert.Equal(t, "user-ec", caller.ID)
+
+	// An RSA token naming the EC key must not verify
+	_, err = authenticator.Authenticate(sign(t, jwt.SigningMethodRS256, "ec-1", rsaKey, claims("user-rsa")))
+	assert.ErrorIs(t, err, repoerr.ErrUnauthenticated)
+
+	// HS256 is off without a secret
+	_, err = authenticator.Authenticate(sign(t, jwt.SigningMethodHS256, "", []byte(secret), claims("user-1")))
+	assert.ErrorIs(t, err, repoerr.ErrUnauthenticated)
+}
+
+func TestAuthenticate_Error(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(config.AuthConfig{HMACSecret: secret, Issuer: "bitbridge", Audience: "repos"})
+	assert.Nil(t, err)
+
+	valid := claims("user-1")
+	valid.Issuer = "bitbridge"
+	valid.Audience = jwt.ClaimStrings{"repos"}
+
+	expired := valid
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+
+	noExpiry := valid
+	noExpiry.ExpiresAt = nil
+
+	noSubject := valid
+	noSubject.Subject = ""
+
+	wrongIssuer := valid
+	wrongIssuer.Issuer = "elsewhere"
+
+	wrongAudience := valid
+	wrongAudience.Audience = jwt.ClaimStrings{"billing"}
+
+	testCases := []struct {
+		name  string
+		token string
+	}{
+		{"garbage", "not-a-token"},
+		{"wrong secret", sign(t, jwt.SigningMethodHS256, "", []byte(strings.Repeat("x", 32)), valid)},
+		{"wrong algorithm", sign(t, jwt.SigningMethodHS512, "", []byte(secret), valid)},
+		{"unsigned", sign(t, jwt.SigningMethodNone, "", jwt.UnsafeAllowNoneSignatureType, valid)},
+		{"expired", sign(t, jwt.SigningMethodHS256, "", []byte(secret), expired)},
+		{"no expiry", sign(t, jwt.SigningMethodHS256, "", []byte(secret), noExpiry)},
+		{"no subject", sign(t, jwt.SigningMethodHS256, "", []byte(secret), noSubject)},
+		{"wrong issuer", sign(t, jwt.SigningMethodHS256, "", []byte(secret), wrongIssuer)},
+		{"wrong audience", sign(t, jwt.SigningMethodHS256, "", []byte(secret), wrongAudience)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := authenticator.Authenticate(tc.token)
+
+			assert.ErrorIs(t, err, repoerr.ErrUnauthenticated)
+		})
+	}
+}
+
+func TestAuthenticateHeader(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(config.AuthConfig{HMACSecret: secret})
+	assert.Nil(t, err)
+
+	_, ok, err := authenticator.AuthenticateHeader("")
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	caller, ok, err := authenticator.AuthenticateHeader("Bearer " + sign(t, jwt.SigningMethodHS256, "", []byte(secret), claims("user-1")))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "user-1", caller.ID)
+
+	_, _, err = authenticator.AuthenticateHeader("Basic dXNlcjpwYXNz")
+	assert.ErrorIs(t, err, repoerr.ErrUnauthenticated)
+}
+
+func TestNewAuthenticator_Error_InvalidJWKS(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	assert.Nil(t, os.WriteFile(path, []byte(`{"keys": [{"kty": "EC", "kid": "ec-1", "crv": "P-384", "x": "AQ", "y": "AQ"}]}`), 0o600))
+
+	_, err := auth.NewAuthenticator(config.AuthConfig{JWKSFile: path})
+
+	assert.ErrorContains(t, err, "unsupported curve")
+
+	_, err = auth.NewAuthenticator(config.AuthConfig{JWKSFile: filepath.Join(t.TempDir(), "missing.json")})
+
+	assert.ErrorContains(t, err, "read jwks")
+}
@@ -0,0 +1,197 @@
+// Package authz decides what a caller may do to a repo. The service consults a
+// Policy before every change; RolePolicy is the default, other rules can be plugged
+// in by implementing Policy.
+package authz
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Action is something a caller does to a repo
+type Action string
+
+const (
+	ActionUpdate              Action = "update"
+	ActionDelete              Action = "delete"
+	ActionSetVisibility       Action = "set_visibility"
+	ActionListCollaborators   Action = "list_collaborators"
+	ActionManageCollaborators Action = "manage_collaborators"
+	ActionTransfer            Action = "transfer"
+	ActionArchive             Action = "archive" // Archiving and unarchiving
+)
+
+// Role is what a caller is to a repo
+type Role string
+
+const (
+	RoleOwner     Role = "owner"
+	RoleAdmin     Role = "admin"      // Granted platform wide by the token's roles claim
+	RoleOrgOwner  Role = "org:owner"  // Owner of the organization owning the repo
+	RoleOrgMember Role = "org:member" // Member of the organization owning the repo
+)
+
+// CollaboratorRole is the Role of a collaborator holding one of the model's
+// Collaborator* roles
+func CollaboratorRole(role string) Role {
+	return Role("collaborator:" + role)
+}
+
+// Policy decides whether caller may perform action on repo. A refusal is returned
+// as a repoerr PermissionDenied error, or Unauthenticated for anonymous callers.
+type Policy interface {
+	Authorize(ctx context.Context, caller identity.Caller, action Action, repo *model.PrivateRepoModel) error
+}
+
+// PolicyFunc lets a plain function be used as a Policy
+type PolicyFunc func(ctx context.Context, caller identity.Caller, action Action, repo *model.PrivateRepoModel) error
+
+func (f PolicyFunc) Authorize(ctx context.Context, caller identity.Caller, action Action, repo *model.PrivateRepoModel) error {
+	return f(ctx, caller, action, repo)
+}
+
+// Collaborators finds the collaborator entry of a user, a repoerr NotFound error
+// means the user does not collaborate on the repo
+type Collaborators interface {
+	Find(ctx context.Context, repoID primitive.ObjectID, userID string) (*model.Collaborator, error)
+}
+
+// Members finds the membership of a user in an organization, a repoerr NotFound
+// error means the user is not a member
+type Members interface {
+	FindMember(ctx context.Context, orgID primitive.ObjectID, userID string) (*model.OrgMember, error)
+}
+
+// Teams lists the teams of a user that hold a role on a repo
+type Teams interface {
+	ListByRepoAndMember(ctx context.Context, repoID primitive.ObjectID, userID string) ([]*model.Team, error)
+}
+
+// RolePolicy allows an action when any role the caller holds on the repo is granted it
+type RolePolicy struct {
+	Grants        map[Role][]Action
+	Collaborators Collaborators // Nil means nobody is a collaborator
+	Members       Members       // Nil means organizations have no members
+	Teams         Teams         // Nil means teams hold no roles
+}
+
+// NewRolePolicy lets owners and admins do everything, for an organization's repos
+// so do the organization's owners. Collaborators and teams get more the higher their
+// role: write lists collaborators, maintain also updates the repo and admin does
+// everything the owner does but transfer it. Plain organization members only see
+// the repos.
+func NewRolePolicy(collaborators Collaborators, members Members, teams Teams) *RolePolicy {
+	manage := []Action{ActionUpdate, ActionDelete, ActionSetVisibility, ActionArchive, ActionListCollaborators, ActionManageCollaborators}
+	all := append([]Action{ActionTransfer}, manage...)
+
+	return &RolePolicy{
+		Grants: map[Role][]Action{
+			RoleOwner:    all,
+			RoleAdmin:    all,
+			RoleOrgOwner: all,
+			CollaboratorRole(model.CollaboratorWrite):    {ActionListCollaborators},
+			CollaboratorRole(model.CollaboratorMaintain): {ActionUpdate, ActionListCollaborators},
+			CollaboratorRole(model.CollaboratorAdmin):    manage,
+		},
+		Collaborators: collaborators,
+		Members:       members,
+		Teams:         teams,
+	}
+}
+
+func (p *RolePolicy) Authorize(ctx context.Context, caller identity.Caller, action Action, repo *model.PrivateRepoModel) error {
+	if caller.ID == "" {
+		return repoerr.Unauthenticated("sign in to %s a repo", verb(action))
+	}
+
+	roles, err := p.Roles(ctx, caller, repo)
+	if err != nil {
+		return err
+	}
+
+	for _, role := range roles {
+		for _, granted := range p.Grants[role] {
+			if granted == action {
+				return nil
+			}
+		}
+	}
+
+	return repoerr.PermissionDenied("not allowed to %s this repo", verb(action))
+}
+
+// Roles lists the roles caller holds on repo
+func (p *RolePolicy) Roles(ctx context.Context, caller identity.Caller, repo *model.PrivateRepoModel) ([]Role, error) {
+	roles := []Role{}
+	if caller.ID == "" {
+		return roles, nil
+	}
+
+	orgID, ownedByOrg := repo.OrganizationID()
+	if !ownedByOrg && caller.ID == repo.OwnerID {
+		roles = append(roles, RoleOwner)
+	}
+	if caller.HasRole(string(RoleAdmin)) {
+		roles = append(roles, RoleAdmin)
+	}
+
+	if p.Collaborators != nil {
+		collaborator, err := p.Collaborators.Find(ctx, repo.ID, caller.ID)
+		switch {
+		case errors.Is(err, repoerr.ErrNotFound):
+		case err != nil:
+			return nil, err
+		default:
+			roles = append(roles, CollaboratorRole(collaborator.Role))
+		}
+	}
+
+	if !ownedByOrg {
+		return roles, nil
+	}
+
+	if p.Members != nil {
+		member, err := p.Members.FindMember(ctx, orgID, caller.ID)
+		switch {
+		case errors.Is(err, repoerr.ErrNotFound):
+		case err != nil:
+			return nil, err
+		case member.Role == model.OrgRoleOwner:
+			roles = append(roles, RoleOrgOwner)
+		default:
+			roles = append(roles, RoleOrgMember)
+		}
+	}
+
+	if p.Teams != nil {
+		teams, err := p.Teams.ListByRepoAndMember(ctx, repo.ID, caller.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, team := range teams {
+			if role, ok := team.RepoRole(repo.ID); ok {
+				roles = append(roles, CollaboratorRole(role))
+			}
+		}
+	}
+
+	return roles, nil
+}
+
+func verb(action Action) string {
+	switch action {
+	case ActionSetVisibility:
+		return "change the visibility of"
+	case ActionListCollaborators:
+		return "list the collaborators of"
+	case ActionManageCollaborators:
+		return "manage the collaborators of"
+	}
+
+	return string(action)
+}
@@ -0,0 +1,142 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/authz"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type collaborators map[string]string
+
+func (c collaborators) Find(ctx context.Context, repoID primitive.ObjectID, userID string) (*model.Collaborator, error) {
+	role, ok := c[userID]
+	if !ok {
+		return nil, repoerr.NotFound("collaborator not found")
+	}
+
+	return &model.Collaborator{RepoID: repoID, UserID: userID, Role: role}, nil
+}
+
+type members map[string]string
+
+func (m members) FindMember(ctx context.Context, orgID primitive.ObjectID, userID string) (*model.OrgMember, error) {
+	role, ok := m[userID]
+	if !ok {
+		return nil, repoerr.NotFound("member not found")
+	}
+
+	return &model.OrgMember{UserID: userID, Role: role}, nil
+}
+
+type teams map[string]string
+
+func (t teams) ListByRepoAndMember(ctx context.Context, repoID primitive.ObjectID, userID string) ([]*model.Team, error) {
+	role, ok := t[userID]
+	if !ok {
+		return []*model.Team{}, nil
+	}
+
+	return []*model.Team{{Members: []string{userID}, Repos: []model.TeamRepo{{RepoID: repoID, Role: role}}}}, nil
+}
+
+func TestRolePolicy_Authorize(t *testing.T) {
+	policy := authz.NewRolePolicy(collaborators{
+		"reader":     model.CollaboratorRead,
+		"writer":     model.CollaboratorWrite,
+		"maintainer": model.CollaboratorMaintain,
+		"repo-admin": model.CollaboratorAdmin,
+	}, nil, nil)
+	repo := &model.PrivateRepoModel{OwnerID: "owner"}
+
+	testCases := []struct {
+		name     string
+		caller   identity.Caller
+		action   authz.Action
+		expected error
+	}{
+		{"owner deletes", identity.Caller{ID: "owner"}, authz.ActionDelete, nil},
+		{"admin deletes", identity.Caller{ID: "admin", Roles: []string{"admin"}}, authz.ActionDelete, nil},
+		{"reader updates", identity.Caller{ID: "reader"}, authz.ActionUpdate, repoerr.ErrPermissionDenied},
+		{"writer lists collaborators", identity.Caller{ID: "writer"}, authz.ActionListCollaborators, nil},
+		{"writer updates", identity.Caller{ID: "writer"}, authz.ActionUpdate, repoerr.ErrPermissionDenied},
+		{"maintainer updates", identity.Caller{ID: "maintainer"}, authz.ActionUpdate, nil},
+		{"maintainer deletes", identity.Caller{ID: "maintainer"}, authz.ActionDelete, repoerr.ErrPermissionDenied},
+		{"maintainer manages collaborators", identity.Caller{ID: "maintainer"}, authz.ActionManageCollaborators, repoerr.ErrPermissionDenied},
+		{"repo admin changes visibility", identity.Caller{ID: "repo-admin"}, authz.ActionSetVisibility, nil},
+		{"repo admin manages collaborators", identity.Caller{ID: "repo-admin"}, authz.ActionManageCollaborators, nil},
+		{"repo admin archives", identity.Caller{ID: "repo-admin"}, authz.ActionArchive, nil},
+		{"maintainer archives", identity.Caller{ID: "maintainer"}, authz.ActionArchive, repoerr.ErrPermissionDenied},
+		{"repo admin transfers", identity.Caller{ID: "repo-admin"}, authz.ActionTransfer, repoerr.ErrPermissionDenied},
+		{"owner transfers", identity.Caller{ID: "owner"}, authz.ActionTransfer, nil},
+		{"stranger updates", identity.Caller{ID: "someone"}, authz.ActionUpdate, repoerr.ErrPermissionDenied},
+		{"anonymous updates", identity.Caller{}, authz.ActionUpdate, repoerr.ErrUnauthenticated},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := policy.Authorize(context.Background(), tc.caller, tc.action, repo)
+
+			if tc.expected == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRolePolicy_Roles(t *testing.T) {
+	policy := authz.NewRolePolicy(collaborators{"owner": model.CollaboratorRead}, nil, nil)
+	repo := &model.PrivateRepoModel{OwnerID: "owner"}
+
+	roles, err := policy.Roles(context.Background(), identity.Caller{ID: "owner", Roles: []string{"admin"}}, repo)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []authz.Role{authz.RoleOwner, authz.RoleAdmin, authz.CollaboratorRole(model.CollaboratorRead)}, roles)
+}
+
+func TestRolePolicy_Organization(t *testing.T) {
+	policy := authz.NewRolePolicy(
+		collaborators{},
+		members{"org-owner": model.OrgRoleOwner, "org-member": model.OrgRoleMember, "team-maintainer": model.OrgRoleMember},
+		teams{"team-maintainer": model.CollaboratorMaintain},
+	)
+	orgID := primitive.NewObjectID()
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: orgID.Hex(), OwnerType: model.OwnerOrganization}
+
+	testCases := []struct {
+		name     string
+		caller   identity.Caller
+		action   authz.Action
+		expected error
+	}{
+		{"org owner deletes", identity.Caller{ID: "org-owner"}, authz.ActionDelete, nil},
+		{"org owner transfers", identity.Caller{ID: "org-owner"}, authz.ActionTransfer, nil},
+		{"org member updates", identity.Caller{ID: "org-member"}, authz.ActionUpdate, repoerr.ErrPermissionDenied},
+		{"team maintainer updates", identity.Caller{ID: "team-maintainer"}, authz.ActionUpdate, nil},
+		{"team maintainer deletes", identity.Caller{ID: "team-maintainer"}, authz.ActionDelete, repoerr.ErrPermissionDenied},
+		{"user named like the org deletes", identity.Caller{ID: orgID.Hex()}, authz.ActionDelete, repoerr.ErrPermissionDenied},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := policy.Authorize(context.Background(), tc.caller, tc.action, repo)
+
+			if tc.expected == nil {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorIs(t, err, tc.expected)
+			}
+		})
+	}
+
+	roles, err := policy.Roles(context.Background(), identity.Caller{ID: "team-maintainer"}, repo)
+	assert.Nil(t, err)
+	assert.Equal(t, []authz.Role{authz.RoleOrgMember, authz.CollaboratorRole(model.CollaboratorMaintain)}, roles)
+}
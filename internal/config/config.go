@@ -0,0 +1,376 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/naming"
+	"gopkg.in/yaml.v3"
+)
+
+const envPrefix = "REPO_"
+
+type Config struct {
+	HTTP            HTTPConfig    `json:"http" yaml:"http"`
+	GRPC            GRPCConfig    `json:"grpc" yaml:"grpc"`
+	Storage         StorageConfig `json:"storage" yaml:"storage"`
+	Mongo           MongoConfig   `json:"mongo" yaml:"mongo"`
+	Names           naming.Policy `json:"names" yaml:"names"`                     // Rules for repo names
+	Auth            AuthConfig    `json:"auth" yaml:"auth"`                       // Trusted bearer token keys
+	Trash           TrashConfig   `json:"trash" yaml:"trash"`                     // How long deleted repos are kept
+	ShutdownTimeout Duration      `json:"shutdownTimeout" yaml:"shutdownTimeout"` // Deadline for draining in-flight requests
+}
+
+type HTTPConfig struct {
+	Addr         string   `json:"addr" yaml:"addr"`
+	ReadTimeout  Duration `json:"readTimeout" yaml:"readTimeout"`
+	WriteTimeout Duration `json:"writeTimeout" yaml:"writeTimeout"`
+	IdleTimeout  Duration `json:"idleTimeout" yaml:"idleTimeout"`
+}
+
+type GRPCConfig struct {
+	Addr string `json:"addr" yaml:"addr"`
+}
+
+const (
+	BackendMongo  = "mongo"
+	BackendMemory = "memory"
+)
+
+type StorageConfig struct {
+	Backend string `json:"backend" yaml:"backend"` // "mongo" or "memory"
+}
+
+type MongoConfig struct {
+	URI                    string   `json:"uri" yaml:"uri"`
+	Database               string   `json:"database" yaml:"database"`
+	Collection             string   `json:"collection" yaml:"collection"`
+	AuditCollection        string   `json:"auditCollection" yaml:"auditCollection"`
+	CollaboratorCollection string   `json:"collaboratorCollection" yaml:"collaboratorCollection"`
+	OrganizationCollection string   `json:"organizationCollection" yaml:"organizationCollection"`
+	TeamCollection         string   `json:"teamCollection" yaml:"teamCollection"`
+	RedirectCollection     string   `json:"redirectCollection" yaml:"redirectCollection"`
+	SubscriptionCollection string   `json:"subscriptionCollection" yaml:"subscriptionCollection"`
+	ConnectTimeout         Duration `json:"connectTimeout" yaml:"connectTimeout"`
+}
+
+// AuthConfig says which bearer tokens are trusted, at least one key source is needed
+// for callers to authenticate
+type AuthConfig struct {
+	HMACSecret string `json:"hmacSecret" yaml:"hmacSecret"` // Shared secret for HS256 tokens
+	JWKSFile   string `json:"jwksFile" yaml:"jwksFile"`     // Local JWKS with the RS256 and ES256 keys
+	Issuer     string `json:"issuer" yaml:"issuer"`         // Required iss claim, if set
+	Audience   string `json:"audience" yaml:"audience"`     // Required aud claim, if set
+}
+
+// TrashConfig controls how long deleted repos can be restored and how often the
+// expired ones are purged
+type TrashConfig struct {
+	Retention     Duration `json:"retention" yaml:"retention"`
+	PurgeInterval Duration `json:"purgeInterval" yaml:"purgeInterval"`
+}
+
+// MinHMACSecretLength is the shortest HS256 secret accepted, RFC 7518 asks for at
+// least the size of the hash output
+const MinHMACSecretLength = 32
+
+// Duration is a time.Duration that reads and writes "1m30s" style strings
+type Duration time.Duration
+
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(parsed)
+	return nil
+}
+
+func Default() *Config {
+	return &Config{
+		HTTP: HTTPConfig{
+			Addr:         ":8080",
+			ReadTimeout:  Duration(10 * time.Second),
+			WriteTimeout: Duration(10 * time.Second),
+			IdleTimeout:  Duration(60 * time.Second),
+		},
+		GRPC: GRPCConfig{
+			Addr: ":9090",
+		},
+		Storage: StorageConfig{
+			Backend: BackendMongo,
+		},
+		Mongo: MongoConfig{
+			Database:               "bitbridge",
+			Collection:             "repos",
+			AuditCollection:        "repo_audit",
+			CollaboratorCollection: "repo_collaborators",
+			OrganizationCollection: "organizations",
+			TeamCollection:         "teams",
+			RedirectCollection:     "repo_redirects",
+			SubscriptionCollection: "repo_subscriptions",
+			ConnectTimeout:         Duration(10 * time.Second),
+		},
+		Names: *naming.DefaultPolicy(),
+		Trash: TrashConfig{
+			Retention:     Duration(30 * 24 * time.Hour),
+			PurgeInterval: Duration(time.Hour),
+		},
+		ShutdownTimeout: Duration(15 * time.Second),
+	}
+}
+
+// Load builds the configuration from defaults, an optional config file, environment
+// variables and command line flags, in increasing order of precedence.
+func Load(args []string, getenv func(string) string) (*Config, error) {
+	cfg := Default()
+
+	flags := flag.NewFlagSet("repo-service", flag.ContinueOnError)
+	configFile := flags.String("config", getenv(envPrefix+"CONFIG_FILE"), "path to a YAML or JSON config file")
+	overrides := cfg.bindFlags(flags)
+
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if *configFile != "" {
+		if err := cfg.loadFile(*configFile); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.loadEnv(getenv); err != nil {
+		return nil, err
+	}
+
+	// Flags win over everything, but only the ones that were actually set
+	var err error
+	flags.Visit(func(f *flag.Flag) {
+		if apply, ok := overrides[f.Name]; ok && err == nil {
+			err = apply(f.Value.String())
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) Validate() error {
+	var errs []error
+
+	if c.HTTP.Addr == "" {
+		errs = append(errs, errors.New("http.addr is required"))
+	}
+	if c.GRPC.Addr == "" {
+		errs = append(errs, errors.New("grpc.addr is required"))
+	}
+
+	switch c.Storage.Backend {
+	case BackendMongo:
+		if c.Mongo.URI == "" {
+			errs = append(errs, errors.New("mongo.uri is required"))
+		}
+		if c.Mongo.Database == "" {
+			errs = append(errs, errors.New("mongo.database is required"))
+		}
+		if c.Mongo.Collection == "" {
+			errs = append(errs, errors.New("mongo.collection is required"))
+		}
+		if c.Mongo.AuditCollection == "" {
+			errs = append(errs, errors.New("mongo.auditCollection is required"))
+		}
+		if c.Mongo.CollaboratorCollection == "" {
+			errs = append(errs, errors.New("mongo.collaboratorCollection is required"))
+		}
+		if c.Mongo.OrganizationCollection == "" {
+			errs = append(errs, errors.New("mongo.organizationCollection is required"))
+		}
+		if c.Mongo.TeamCollection == "" {
+			errs = append(errs, errors.New("mongo.teamCollection is required"))
+		}
+		if c.Mongo.RedirectCollection == "" {
+			errs = append(errs, errors.New("mongo.redirectCollection is required"))
+		}
+		if c.Mongo.SubscriptionCollection == "" {
+			errs = append(errs, errors.New("mongo.subscriptionCollection is required"))
+		}
+	case BackendMemory:
+	default:
+		errs = append(errs, fmt.Errorf("storage.backend must be %q or %q", BackendMongo, BackendMemory))
+	}
+
+	if c.Names.MinLength < 1 {
+		errs = append(errs, errors.New("names.minLength must be at least 1"))
+	}
+	if c.Names.MaxLength < c.Names.MinLength {
+		errs = append(errs, errors.New("names.maxLength must not be less than names.minLength"))
+	}
+	if c.Names.Charset == "" {
+		errs = append(errs, errors.New("names.charset is required"))
+	}
+
+	if c.Auth.HMACSecret != "" && len(c.Auth.HMACSecret) < MinHMACSecretLength {
+		errs = append(errs, fmt.Errorf("auth.hmacSecret must be at least %d bytes", MinHMACSecretLength))
+	}
+
+	for _, d := range []struct {
+		name  string
+		value Duration
+	}{
+		{"http.readTimeout", c.HTTP.ReadTimeout},
+		{"http.writeTimeout", c.HTTP.WriteTimeout},
+		{"http.idleTimeout", c.HTTP.IdleTimeout},
+		{"mongo.connectTimeout", c.Mongo.ConnectTimeout},
+		{"trash.retention", c.Trash.Retention},
+		{"trash.purgeInterval", c.Trash.PurgeInterval},
+		{"shutdownTimeout", c.ShutdownTimeout},
+	} {
+		if d.value <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be positive", d.name))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// settings maps the env/flag names to setters on the config
+func (c *Config) settings() map[string]func(string) error {
+	return map[string]func(string) error{
+		"http-addr":                     setString(&c.HTTP.Addr),
+		"http-read-timeout":             c.HTTP.ReadTimeout.set,
+		"http-write-timeout":            c.HTTP.WriteTimeout.set,
+		"http-idle-timeout":             c.HTTP.IdleTimeout.set,
+		"grpc-addr":                     setString(&c.GRPC.Addr),
+		"storage-backend":               setString(&c.Storage.Backend),
+		"mongo-uri":                     setString(&c.Mongo.URI),
+		"mongo-database":                setString(&c.Mongo.Database),
+		"mongo-collection":              setString(&c.Mongo.Collection),
+		"mongo-audit-collection":        setString(&c.Mongo.AuditCollection),
+		"mongo-collaborator-collection": setString(&c.Mongo.CollaboratorCollection),
+		"mongo-organization-collection": setString(&c.Mongo.OrganizationCollection),
+		"mongo-team-collection":         setString(&c.Mongo.TeamCollection),
+		"mongo-redirect-collection":     setString(&c.Mongo.RedirectCollection),
+		"mongo-subscription-collection": setString(&c.Mongo.SubscriptionCollection),
+		"mongo-connect-timeout":         c.Mongo.ConnectTimeout.set,
+		"names-min-length":              setInt(&c.Names.MinLength),
+		"names-max-length":              setInt(&c.Names.MaxLength),
+		"names-charset":                 setString(&c.Names.Charset),
+		"names-reserved":                setList(&c.Names.Reserved),
+		"names-forbidden-suffixes":      setList(&c.Names.ForbiddenSuffixes),
+		"auth-hmac-secret":              setString(&c.Auth.HMACSecret),
+		"auth-jwks-file":                setString(&c.Auth.JWKSFile),
+		"auth-issuer":                   setString(&c.Auth.Issuer),
+		"auth-audience":                 setString(&c.Auth.Audience),
+		"trash-retention":               c.Trash.Retention.set,
+		"trash-purge-interval":          c.Trash.PurgeInterval.set,
+		"shutdown-timeout":              c.ShutdownTimeout.set,
+	}
+}
+
+func (c *Config) bindFlags(flags *flag.FlagSet) map[string]func(string) error {
+	settings := c.settings()
+	for name := range settings {
+		flags.String(name, "", "overrides "+envName(name))
+	}
+
+	return settings
+}
+
+func (c *Config) loadEnv(getenv func(string) string) error {
+	for name, apply := range c.settings() {
+		value := getenv(envName(name))
+		if value == "" {
+			continue
+		}
+
+		if err := apply(value); err != nil {
+			return fmt.Errorf("%s: %w", envName(name), err)
+		}
+	}
+
+	return nil
+}
+
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(data, c)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, c)
+	default:
+		return fmt.Errorf("unsupported config file extension %q", filepath.Ext(path))
+	}
+
+	if err != nil {
+		return fmt.Errorf("parse config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func (d *Duration) set(value string) error {
+	return d.UnmarshalText([]byte(value))
+}
+
+func setString(target *string) func(string) error {
+	return func(value string) error {
+		*target = value
+		return nil
+	}
+}
+
+func setInt(target *int) func(string) error {
+	return func(value string) error {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+
+		*target = parsed
+		return nil
+	}
+}
+
+// setList reads a comma separated list, an empty value clears it
+func setList(target *[]string) func(string) error {
+	return func(value string) error {
+		*target = []string{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*target = append(*target, item)
+			}
+		}
+		return nil
+	}
+}
+
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
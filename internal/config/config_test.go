@@ -0,0 +1,146 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func envFrom(values map[string]string) func(string) string {
+	return func(key string) string {
+		return values[key]
+	}
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	cfg, err := config.Load(nil, envFrom(map[string]string{"REPO_MONGO_URI": "mongodb://localhost"}))
+
+	assert.Nil(t, err)
+	assert.Equal(t, ":8080", cfg.HTTP.Addr)
+	assert.Equal(t, "repos", cfg.Mongo.Collection)
+	assert.Equal(t, config.Duration(15*time.Second), cfg.ShutdownTimeout)
+}
+
+func TestLoad_Precedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	err := os.WriteFile(path, []byte("http:\n  addr: \":9000\"\nmongo:\n  uri: mongodb://file\n  database: fromfile\nshutdownTimeout: 30s\n"), 0o600)
+	assert.Nil(t, err)
+
+	env := envFrom(map[string]string{
+		"REPO_CONFIG_FILE":    path,
+		"REPO_MONGO_DATABASE": "fromenv",
+		"REPO_HTTP_ADDR":      ":9100",
+	})
+
+	cfg, err := config.Load([]string{"-http-addr", ":9200"}, env)
+
+	assert.Nil(t, err)
+	assert.Equal(t, ":9200", cfg.HTTP.Addr)
+	assert.Equal(t, "mongodb://file", cfg.Mongo.URI)
+	assert.Equal(t, "fromenv", cfg.Mongo.Database)
+	assert.Equal(t, config.Duration(30*time.Second), cfg.ShutdownTimeout)
+}
+
+func TestLoad_JSONFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	err := os.WriteFile(path, []byte(`{"mongo": {"uri": "mongodb://json", "connectTimeout": "3s"}}`), 0o600)
+	assert.Nil(t, err)
+
+	cfg, err := config.Load([]string{"-config", path}, envFrom(nil))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "mongodb://json", cfg.Mongo.URI)
+	assert.Equal(t, config.Duration(3*time.Second), cfg.Mongo.ConnectTimeout)
+}
+
+func TestLoad_Error_MissingURI(t *testing.T) {
+	_, err := config.Load(nil, envFrom(nil))
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "mongo.uri is required")
+}
+
+func TestLoad_Error_InvalidDuration(t *testing.T) {
+	_, err := config.Load([]string{"-shutdown-timeout", "soon"}, envFrom(map[string]string{"REPO_MONGO_URI": "mongodb://localhost"}))
+
+	assert.NotNil(t, err)
+}
+
+func TestLoad_Error_NonPositiveDuration(t *testing.T) {
+	_, err := config.Load(nil, envFrom(map[string]string{
+		"REPO_MONGO_URI":        "mongodb://localhost",
+		"REPO_SHUTDOWN_TIMEOUT": "0s",
+	}))
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "shutdownTimeout must be positive")
+}
+
+func TestLoad_MemoryBackend(t *testing.T) {
+	cfg, err := config.Load([]string{"-storage-backend", "memory"}, envFrom(nil))
+
+	assert.Nil(t, err)
+	assert.Equal(t, config.BackendMemory, cfg.Storage.Backend)
+}
+
+func TestLoad_Error_UnknownBackend(t *testing.T) {
+	_, err := config.Load(nil, envFrom(map[string]string{"REPO_STORAGE_BACKEND": "sqlite"}))
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "storage.backend")
+}
+
+func TestLoad_Names(t *testing.T) {
+	cfg, err := config.Load([]string{"-names-max-length", "20"}, envFrom(map[string]string{
+		"REPO_STORAGE_BACKEND": "memory",
+		"REPO_NAMES_RESERVED":  "admin, billing",
+	}))
+
+	assert.Nil(t, err)
+	assert.Equal(t, 20, cfg.Names.MaxLength)
+	assert.Equal(t, []string{"admin", "billing"}, cfg.Names.Reserved)
+	assert.Equal(t, []string{".git", ".atom"}, cfg.Names.ForbiddenSuffixes)
+}
+
+func TestLoad_Error_InvalidNames(t *testing.T) {
+	_, err := config.Load([]string{"-names-min-length", "10", "-names-max-length", "5"}, envFrom(map[string]string{"REPO_STORAGE_BACKEND": "memory"}))
+
+	assert.ErrorContains(t, err, "names.maxLength")
+}
+
+func TestLoad_Auth(t *testing.T) {
+	cfg, err := config.Load([]string{"-auth-issuer", "bitbridge"}, envFrom(map[string]string{
+		"REPO_STORAGE_BACKEND":  "memory",
+		"REPO_AUTH_HMAC_SECRET": strings.Repeat("s", config.MinHMACSecretLength),
+		"REPO_AUTH_JWKS_FILE":   "/etc/repo/jwks.json",
+	}))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "bitbridge", cfg.Auth.Issuer)
+	assert.Equal(t, "/etc/repo/jwks.json", cfg.Auth.JWKSFile)
+}
+
+func TestLoad_Error_ShortHMACSecret(t *testing.T) {
+	_, err := config.Load(nil, envFrom(map[string]string{
+		"REPO_STORAGE_BACKEND":  "memory",
+		"REPO_AUTH_HMAC_SECRET": "short",
+	}))
+
+	assert.ErrorContains(t, err, "auth.hmacSecret")
+}
+
+func TestLoad_Trash(t *testing.T) {
+	cfg, err := config.Load([]string{"-trash-retention", "168h"}, envFrom(map[string]string{
+		"REPO_STORAGE_BACKEND":      "memory",
+		"REPO_TRASH_PURGE_INTERVAL": "10m",
+	}))
+
+	assert.Nil(t, err)
+	assert.Equal(t, config.Duration(7*24*time.Hour), cfg.Trash.Retention)
+	assert.Equal(t, config.Duration(10*time.Minute), cfg.Trash.PurgeInterval)
+}
@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/auth"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// AuthInterceptor puts the caller of the bearer token in the authorization metadata
+// into the request context. Calls without one stay anonymous.
+func AuthInterceptor(authenticator *auth.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var header string
+		if values := metadata.ValueFromIncomingContext(ctx, "authorization"); len(values) > 0 {
+			header = values[0]
+		}
+
+		caller, ok, err := authenticator.AuthenticateHeader(header)
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		if ok {
+			ctx = identity.NewContext(ctx, caller)
+		}
+
+		return handler(ctx, req)
+	}
+}
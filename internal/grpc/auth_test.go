@@ -0,0 +1,63 @@
+package grpc_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/auth"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/config"
+	repogrpc "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/grpc"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	secret := strings.Repeat("s", config.MinHMACSecretLength)
+	authenticator, err := auth.NewAuthenticator(config.AuthConfig{HMACSecret: secret})
+	assert.Nil(t, err)
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   "user-1",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}).SignedString([]byte(secret))
+	assert.Nil(t, err)
+
+	interceptor := repogrpc.AuthInterceptor(authenticator)
+	callerOf := func(ctx context.Context, req interface{}) (interface{}, error) {
+		caller, _ := identity.FromContext(ctx)
+		return caller.ID, nil
+	}
+
+	testCases := []struct {
+		name     string
+		header   string
+		expected interface{}
+		code     codes.Code
+	}{
+		{"anonymous", "", "", codes.OK},
+		{"valid token", "Bearer " + token, "user-1", codes.OK},
+		{"invalid token", "Bearer " + token + "x", nil, codes.Unauthenticated},
+		{"not a bearer token", "Basic dXNlcjpwYXNz", nil, codes.Unauthenticated},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tc.header))
+			}
+
+			resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, callerOf)
+
+			assert.Equal(t, tc.code, status.Code(err))
+			assert.Equal(t, tc.expected, resp)
+		})
+	}
+}
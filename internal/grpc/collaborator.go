@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/validation"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public/proto/repov1"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type CollaboratorServer struct {
+	repov1.UnimplementedCollaboratorServiceServer
+	Service service.CollaboratorService
+}
+
+func NewCollaboratorServer(service service.CollaboratorService) *CollaboratorServer {
+	return &CollaboratorServer{
+		Service: service,
+	}
+}
+
+func (s *CollaboratorServer) ListCollaborators(ctx context.Context, req *repov1.ListCollaboratorsRequest) (*repov1.ListCollaboratorsResponse, error) {
+	collaborators, err := s.Service.ListCollaborators(ctx, req.GetRepoId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &repov1.ListCollaboratorsResponse{Collaborators: make([]*repov1.Collaborator, 0, len(collaborators))}
+	for _, collaborator := range collaborators {
+		resp.Collaborators = append(resp.Collaborators, collaboratorToProto(collaborator))
+	}
+
+	return resp, nil
+}
+
+func (s *CollaboratorServer) AddCollaborator(ctx context.Context, req *repov1.AddCollaboratorRequest) (*repov1.Collaborator, error) {
+	body := &public_repo.AddCollaboratorModel{UserID: req.GetUserId(), Role: req.GetRole()}
+	if err := validation.Struct(body); err != nil {
+		return nil, toStatus(err)
+	}
+
+	collaborator, err := s.Service.AddCollaborator(ctx, req.GetRepoId(), body.UserID, body.Role)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return collaboratorToProto(collaborator), nil
+}
+
+func (s *CollaboratorServer) SetCollaboratorRole(ctx context.Context, req *repov1.SetCollaboratorRoleRequest) (*repov1.Collaborator, error) {
+	body := &public_repo.CollaboratorRoleModel{Role: req.GetRole()}
+	if err := validation.Struct(body); err != nil {
+		return nil, toStatus(err)
+	}
+
+	collaborator, err := s.Service.SetCollaboratorRole(ctx, req.GetRepoId(), req.GetUserId(), body.Role)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return collaboratorToProto(collaborator), nil
+}
+
+func (s *CollaboratorServer) RemoveCollaborator(ctx context.Context, req *repov1.RemoveCollaboratorRequest) (*emptypb.Empty, error) {
+	if err := s.Service.RemoveCollaborator(ctx, req.GetRepoId(), req.GetUserId()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func collaboratorToProto(collaborator *model.Collaborator) *repov1.Collaborator {
+	return &repov1.Collaborator{
+		UserId:    collaborator.UserID,
+		Role:      collaborator.Role,
+		CreatedAt: timestamppb.New(collaborator.CreatedAt),
+		UpdatedAt: timestamppb.New(collaborator.UpdatedAt),
+	}
+}
@@ -0,0 +1,28 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public/proto/repov1"
+)
+
+type CountServer struct {
+	repov1.UnimplementedCountServiceServer
+	Service service.CountService
+}
+
+func NewCountServer(service service.CountService) *CountServer {
+	return &CountServer{
+		Service: service,
+	}
+}
+
+func (s *CountServer) ReconcileRepoCounts(ctx context.Context, req *repov1.ReconcileRepoCountsRequest) (*repov1.Repo, error) {
+	repo, err := s.Service.ReconcileCounts(ctx, req.GetRepoId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toProto(repo), nil
+}
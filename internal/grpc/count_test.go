@@ -0,0 +1,50 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	repogrpc "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/grpc"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public/proto/repov1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CountServiceMock struct {
+	mock.Mock
+}
+
+func (s *CountServiceMock) ReconcileCounts(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func TestReconcileRepoCounts_Success(t *testing.T) {
+	serviceMock := new(CountServiceMock)
+	repo := newRepo()
+	repo.ForksCount = 2
+
+	serviceMock.On("ReconcileCounts", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	resp, err := repogrpc.NewCountServer(serviceMock).ReconcileRepoCounts(context.TODO(), &repov1.ReconcileRepoCountsRequest{RepoId: repo.ID.Hex()})
+
+	assert.Nil(t, err)
+	assert.Equal(t, repo.ID.Hex(), resp.GetId())
+	assert.Equal(t, int64(2), resp.GetForksCount())
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestReconcileRepoCounts_Error_NotAdmin(t *testing.T) {
+	serviceMock := new(CountServiceMock)
+
+	serviceMock.On("ReconcileCounts", mock.Anything, "id").Return((*model.PrivateRepoModel)(nil), repoerr.PermissionDenied("only admins reconcile counts"))
+
+	_, err := repogrpc.NewCountServer(serviceMock).ReconcileRepoCounts(context.TODO(), &repov1.ReconcileRepoCountsRequest{RepoId: "id"})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"errors"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func toStatus(err error) error {
+	code := statusCode(err)
+	if code == codes.Internal {
+		return status.Error(code, "internal error")
+	}
+
+	st := status.New(code, repoerr.PublicMessage(err, code.String()))
+
+	violations := repoerr.ViolationsOf(err)
+	if len(violations) == 0 {
+		return st.Err()
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Message,
+		})
+	}
+
+	if detailed, detailErr := st.WithDetails(badRequest); detailErr == nil {
+		st = detailed
+	}
+
+	return st.Err()
+}
+
+func statusCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, repoerr.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, repoerr.ErrInvalidID), errors.Is(err, repoerr.ErrValidationFailed):
+		return codes.InvalidArgument
+	case errors.Is(err, repoerr.ErrVersionMismatch):
+		return codes.Aborted
+	case errors.Is(err, repoerr.ErrConflict):
+		return codes.AlreadyExists
+	case errors.Is(err, repoerr.ErrReadOnly):
+		return codes.FailedPrecondition
+	case errors.Is(err, repoerr.ErrPermissionDenied):
+		return codes.PermissionDenied
+	case errors.Is(err, repoerr.ErrUnauthenticated):
+		return codes.Unauthenticated
+	case errors.Is(err, repoerr.ErrUnavailable):
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
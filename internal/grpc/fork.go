@@ -0,0 +1,54 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public/proto/repov1"
+)
+
+type ForkServer struct {
+	repov1.UnimplementedForkServiceServer
+	Service service.ForkService
+}
+
+func NewForkServer(service service.ForkService) *ForkServer {
+	return &ForkServer{
+		Service: service,
+	}
+}
+
+func (s *ForkServer) ForkRepo(ctx context.Context, req *repov1.ForkRepoRequest) (*repov1.Repo, error) {
+	fork, err := s.Service.Fork(ctx, req.GetRepoId(), &model.RepoFork{Name: req.GetName(), Organization: req.GetOrganization()})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toProto(fork), nil
+}
+
+func (s *ForkServer) ListForks(ctx context.Context, req *repov1.ListForksRequest) (*repov1.ListReposResponse, error) {
+	return s.list(ctx, req, s.Service.ListForks)
+}
+
+func (s *ForkServer) ListForkNetwork(ctx context.Context, req *repov1.ListForksRequest) (*repov1.ListReposResponse, error) {
+	return s.list(ctx, req, s.Service.ListNetwork)
+}
+
+func (s *ForkServer) list(ctx context.Context, req *repov1.ListForksRequest, list func(ctx context.Context, id string, query *model.RepoListQuery) (*model.RepoPage, error)) (*repov1.ListReposResponse, error) {
+	query := &model.RepoListQuery{
+		Limit:  int(req.GetPageSize()),
+		Cursor: req.GetPageToken(),
+	}
+	if err := setOrderBy(query, req.GetOrderBy()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	page, err := list(ctx, req.GetRepoId(), query)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return pageToProto(page), nil
+}
@@ -0,0 +1,79 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+
+	repogrpc "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/grpc"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public/proto/repov1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ForkServiceMock struct {
+	mock.Mock
+}
+
+func (s *ForkServiceMock) Fork(ctx context.Context, id string, fork *model.RepoFork) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id, fork)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *ForkServiceMock) ListForks(ctx context.Context, id string, query *model.RepoListQuery) (*model.RepoPage, error) {
+	args := s.Called(ctx, id, query)
+	return args.Get(0).(*model.RepoPage), args.Error(1)
+}
+
+func (s *ForkServiceMock) ListNetwork(ctx context.Context, id string, query *model.RepoListQuery) (*model.RepoPage, error) {
+	args := s.Called(ctx, id, query)
+	return args.Get(0).(*model.RepoPage), args.Error(1)
+}
+
+func TestForkRepo_Success(t *testing.T) {
+	serviceMock := new(ForkServiceMock)
+	parentID, rootID := primitive.NewObjectID(), primitive.NewObjectID()
+	fork := newRepo()
+	fork.ParentID = &parentID
+	fork.RootID = &rootID
+
+	serviceMock.On("Fork", mock.Anything, parentID.Hex(), &model.RepoFork{Name: "mine", Organization: "acme"}).Return(fork, nil)
+
+	resp, err := repogrpc.NewForkServer(serviceMock).ForkRepo(context.TODO(), &repov1.ForkRepoRequest{RepoId: parentID.Hex(), Name: "mine", Organization: "acme"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, parentID.Hex(), resp.GetParentId())
+	assert.Equal(t, rootID.Hex(), resp.GetNetworkId())
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestListForkNetwork_Success(t *testing.T) {
+	serviceMock := new(ForkServiceMock)
+	repo := newRepo()
+
+	serviceMock.On("ListNetwork", mock.Anything, repo.ID.Hex(), &model.RepoListQuery{SortBy: model.SortByName, Limit: 5}).
+		Return(&model.RepoPage{Repos: []*model.PrivateRepoModel{repo}, NextCursor: "next"}, nil)
+
+	resp, err := repogrpc.NewForkServer(serviceMock).ListForkNetwork(context.TODO(), &repov1.ListForksRequest{RepoId: repo.ID.Hex(), OrderBy: "name", PageSize: 5})
+
+	assert.Nil(t, err)
+	assert.Len(t, resp.GetRepos(), 1)
+	assert.Equal(t, repo.ID.Hex(), resp.GetRepos()[0].GetNetworkId())
+	assert.Equal(t, "next", resp.GetNextPageToken())
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestListForks_Error_InvalidOrderBy(t *testing.T) {
+	serviceMock := new(ForkServiceMock)
+
+	_, err := repogrpc.NewForkServer(serviceMock).ListForks(context.TODO(), &repov1.ListForksRequest{OrderBy: "name sideways"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	serviceMock.AssertExpectations(t)
+}
@@ -0,0 +1,63 @@
+package grpc
+
+import (
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public/proto/repov1"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// immutableFields are the Repo fields an update mask may not name
+var immutableFields = map[string]bool{
+	"id":         true,
+	"owner_id":   true,
+	"created_at": true,
+	"updated_at": true,
+	"version":    true,
+}
+
+// fromFieldMask builds a patch holding the masked fields of repo
+func fromFieldMask(repo *repov1.Repo, mask *fieldmaskpb.FieldMask) (*model.RepoPatch, error) {
+	patch := &model.RepoPatch{Version: repo.GetVersion()}
+
+	paths := mask.GetPaths()
+	if len(paths) == 0 || (len(paths) == 1 && paths[0] == "*") {
+		paths = []string{"name", "description"}
+	}
+
+	violations := []repoerr.Violation{}
+	for _, path := range paths {
+		switch {
+		case path == "name":
+			name := repo.GetName()
+			patch.Name = &name
+		case path == "description":
+			description := repo.GetDescription()
+			patch.Description = &description
+		case path == "topics":
+			topics := append([]string{}, repo.GetTopics()...)
+			patch.Topics = &topics
+		case path == "properties":
+			properties := map[string]string{}
+			for name, value := range repo.GetProperties() {
+				properties[name] = value
+			}
+			patch.Properties = &properties
+		case path == "is_template":
+			isTemplate := repo.GetIsTemplate()
+			patch.IsTemplate = &isTemplate
+		case path == "visibility":
+			violations = append(violations, repoerr.Violation{Field: "update_mask", Message: "visibility must be changed with SetRepoVisibility"})
+		case immutableFields[path]:
+			violations = append(violations, repoerr.Violation{Field: "update_mask", Message: path + " is immutable"})
+		default:
+			violations = append(violations, repoerr.Violation{Field: "update_mask", Message: path + " is not a known field"})
+		}
+	}
+
+	if len(violations) > 0 {
+		return nil, repoerr.Validation(violations...)
+	}
+
+	return patch, nil
+}
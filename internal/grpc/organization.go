@@ -0,0 +1,171 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/validation"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public/proto/repov1"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type OrganizationServer struct {
+	repov1.UnimplementedOrganizationServiceServer
+	Service service.OrganizationService
+}
+
+func NewOrganizationServer(service service.OrganizationService) *OrganizationServer {
+	return &OrganizationServer{
+		Service: service,
+	}
+}
+
+func (s *OrganizationServer) CreateOrganization(ctx context.Context, req *repov1.CreateOrganizationRequest) (*repov1.Organization, error) {
+	body := &public_repo.CreateOrganizationModel{Login: req.GetLogin(), Name: req.GetName()}
+	if err := validation.Struct(body); err != nil {
+		return nil, toStatus(err)
+	}
+
+	org, err := s.Service.CreateOrganization(ctx, body.Login, body.Name)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return organizationToProto(org), nil
+}
+
+func (s *OrganizationServer) GetOrganization(ctx context.Context, req *repov1.GetOrganizationRequest) (*repov1.Organization, error) {
+	org, err := s.Service.FindOrganization(ctx, req.GetLogin())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return organizationToProto(org), nil
+}
+
+func (s *OrganizationServer) SetOrgMember(ctx context.Context, req *repov1.SetOrgMemberRequest) (*repov1.Organization, error) {
+	body := &public_repo.OrgMemberRoleModel{Role: req.GetRole()}
+	if err := validation.Struct(body); err != nil {
+		return nil, toStatus(err)
+	}
+
+	org, err := s.Service.SetOrgMember(ctx, req.GetLogin(), req.GetUserId(), body.Role)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return organizationToProto(org), nil
+}
+
+func (s *OrganizationServer) RemoveOrgMember(ctx context.Context, req *repov1.RemoveOrgMemberRequest) (*emptypb.Empty, error) {
+	if err := s.Service.RemoveOrgMember(ctx, req.GetLogin(), req.GetUserId()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *OrganizationServer) ListTeams(ctx context.Context, req *repov1.ListTeamsRequest) (*repov1.ListTeamsResponse, error) {
+	teams, err := s.Service.ListTeams(ctx, req.GetLogin())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &repov1.ListTeamsResponse{Teams: make([]*repov1.Team, 0, len(teams))}
+	for _, team := range teams {
+		resp.Teams = append(resp.Teams, teamToProto(team))
+	}
+
+	return resp, nil
+}
+
+func (s *OrganizationServer) CreateTeam(ctx context.Context, req *repov1.CreateTeamRequest) (*repov1.Team, error) {
+	body := &public_repo.CreateTeamModel{Slug: req.GetSlug(), Name: req.GetName()}
+	if err := validation.Struct(body); err != nil {
+		return nil, toStatus(err)
+	}
+
+	team, err := s.Service.CreateTeam(ctx, req.GetLogin(), body.Slug, body.Name)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return teamToProto(team), nil
+}
+
+func (s *OrganizationServer) AddTeamMember(ctx context.Context, req *repov1.TeamMemberRequest) (*repov1.Team, error) {
+	team, err := s.Service.AddTeamMember(ctx, req.GetLogin(), req.GetSlug(), req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return teamToProto(team), nil
+}
+
+func (s *OrganizationServer) RemoveTeamMember(ctx context.Context, req *repov1.TeamMemberRequest) (*repov1.Team, error) {
+	team, err := s.Service.RemoveTeamMember(ctx, req.GetLogin(), req.GetSlug(), req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return teamToProto(team), nil
+}
+
+func (s *OrganizationServer) SetTeamRepo(ctx context.Context, req *repov1.SetTeamRepoRequest) (*repov1.Team, error) {
+	body := &public_repo.CollaboratorRoleModel{Role: req.GetRole()}
+	if err := validation.Struct(body); err != nil {
+		return nil, toStatus(err)
+	}
+
+	team, err := s.Service.SetTeamRepo(ctx, req.GetLogin(), req.GetSlug(), req.GetRepoId(), body.Role)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return teamToProto(team), nil
+}
+
+func (s *OrganizationServer) RemoveTeamRepo(ctx context.Context, req *repov1.RemoveTeamRepoRequest) (*repov1.Team, error) {
+	team, err := s.Service.RemoveTeamRepo(ctx, req.GetLogin(), req.GetSlug(), req.GetRepoId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return teamToProto(team), nil
+}
+
+func organizationToProto(org *model.Organization) *repov1.Organization {
+	members := make([]*repov1.OrgMember, 0, len(org.Members))
+	for _, member := range org.Members {
+		members = append(members, &repov1.OrgMember{UserId: member.UserID, Role: member.Role})
+	}
+
+	return &repov1.Organization{
+		Id:        org.ID.Hex(),
+		Login:     org.Login,
+		Name:      org.Name,
+		Members:   members,
+		CreatedAt: timestamppb.New(org.CreatedAt),
+		UpdatedAt: timestamppb.New(org.UpdatedAt),
+	}
+}
+
+func teamToProto(team *model.Team) *repov1.Team {
+	repos := make([]*repov1.TeamRepo, 0, len(team.Repos))
+	for _, repo := range team.Repos {
+		repos = append(repos, &repov1.TeamRepo{RepoId: repo.RepoID.Hex(), Role: repo.Role})
+	}
+
+	return &repov1.Team{
+		Id:        team.ID.Hex(),
+		Slug:      team.Slug,
+		Name:      team.Name,
+		Members:   append([]string{}, team.Members...),
+		Repos:     repos,
+		CreatedAt: timestamppb.New(team.CreatedAt),
+		UpdatedAt: timestamppb.New(team.UpdatedAt),
+	}
+}
@@ -1 +1,285 @@
 package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/validation"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public/proto/repov1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type RepoServer struct {
+	repov1.UnimplementedRepoServiceServer
+	Service service.RepoService
+}
+
+func NewRepoServer(service service.RepoService) *RepoServer {
+	return &RepoServer{
+		Service: service,
+	}
+}
+
+func (s *RepoServer) CreateRepo(ctx context.Context, req *repov1.CreateRepoRequest) (*repov1.Repo, error) {
+	body := &public_repo.CreateRepoModel{
+		Name:         req.GetName(),
+		Description:  req.GetDescription(),
+		Visibility:   req.GetVisibility(),
+		Organization: req.GetOrganization(),
+		ReuseName:    req.GetReuseName(),
+		Template:     req.GetTemplate(),
+		Topics:       req.GetTopics(),
+		Properties:   req.GetProperties(),
+	}
+	if err := validation.Struct(body); err != nil {
+		return nil, toStatus(err)
+	}
+
+	repo, err := s.Service.Create(ctx, body)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toProto(repo), nil
+}
+
+func (s *RepoServer) GetRepo(ctx context.Context, req *repov1.GetRepoRequest) (*repov1.Repo, error) {
+	var (
+		repo *model.PrivateRepoModel
+		err  error
+	)
+
+	switch lookup := req.GetLookup().(type) {
+	case *repov1.GetRepoRequest_Id:
+		repo, err = s.Service.FindById(ctx, lookup.Id)
+	case *repov1.GetRepoRequest_Name:
+		repo, err = s.Service.FindByName(ctx, lookup.Name)
+	case *repov1.GetRepoRequest_FullName:
+		repo, err = s.Service.FindByFullName(ctx, lookup.FullName)
+	case *repov1.GetRepoRequest_Identifier:
+		repo, err = s.Service.FindByFindByIdentifier(ctx, lookup.Identifier)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "one of id, name, identifier or full_name is required")
+	}
+
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toProto(repo), nil
+}
+
+// UpdateRepo patches the fields named in update_mask, an absent mask updates all
+// mutable fields
+func (s *RepoServer) UpdateRepo(ctx context.Context, req *repov1.UpdateRepoRequest) (*repov1.Repo, error) {
+	if req.GetRepo() == nil {
+		return nil, status.Error(codes.InvalidArgument, "repo is required")
+	}
+
+	patch, err := fromFieldMask(req.GetRepo(), req.GetUpdateMask())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	// Only a masked name is updated, so only then do the model's tags apply
+	if patch.Name != nil {
+		body := &public_repo.UpdateRepoModel{Name: *patch.Name, Description: req.GetRepo().GetDescription()}
+		if err := validation.Struct(body); err != nil {
+			return nil, toStatus(err)
+		}
+	}
+
+	updated, err := s.Service.Patch(ctx, req.GetRepo().GetId(), patch)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toProto(updated), nil
+}
+
+func (s *RepoServer) SetRepoVisibility(ctx context.Context, req *repov1.SetRepoVisibilityRequest) (*repov1.Repo, error) {
+	repo, err := s.Service.SetVisibility(ctx, req.GetId(), req.GetVisibility())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toProto(repo), nil
+}
+
+func (s *RepoServer) RenameRepo(ctx context.Context, req *repov1.RenameRepoRequest) (*repov1.Repo, error) {
+	body := &public_repo.RenameRepoModel{Name: req.GetName(), ReuseName: req.GetReuseName()}
+	if err := validation.Struct(body); err != nil {
+		return nil, toStatus(err)
+	}
+
+	repo, err := s.Service.Rename(ctx, req.GetId(), body.Name, body.ReuseName)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toProto(repo), nil
+}
+
+func (s *RepoServer) TransferRepo(ctx context.Context, req *repov1.TransferRepoRequest) (*repov1.Repo, error) {
+	body := &public_repo.TransferRepoModel{
+		NewOwner:          req.GetNewOwner(),
+		NewName:           req.GetNewName(),
+		KeepCollaborators: req.GetKeepCollaborators(),
+		ReuseName:         req.GetReuseName(),
+	}
+	if err := validation.Struct(body); err != nil {
+		return nil, toStatus(err)
+	}
+
+	repo, err := s.Service.Transfer(ctx, req.GetId(), &model.RepoTransfer{
+		NewOwner:          body.NewOwner,
+		NewName:           body.NewName,
+		KeepCollaborators: body.KeepCollaborators,
+		ReuseName:         body.ReuseName,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toProto(repo), nil
+}
+
+func (s *RepoServer) DeleteRepo(ctx context.Context, req *repov1.DeleteRepoRequest) (*emptypb.Empty, error) {
+	repo, err := s.Service.FindById(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if err := s.Service.Delete(ctx, repo); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *RepoServer) ArchiveRepo(ctx context.Context, req *repov1.ArchiveRepoRequest) (*repov1.Repo, error) {
+	repo, err := s.Service.Archive(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toProto(repo), nil
+}
+
+func (s *RepoServer) UnarchiveRepo(ctx context.Context, req *repov1.UnarchiveRepoRequest) (*repov1.Repo, error) {
+	repo, err := s.Service.Unarchive(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toProto(repo), nil
+}
+
+func (s *RepoServer) RestoreRepo(ctx context.Context, req *repov1.RestoreRepoRequest) (*repov1.Repo, error) {
+	repo, err := s.Service.Restore(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return toProto(repo), nil
+}
+
+func (s *RepoServer) ListRepos(ctx context.Context, req *repov1.ListReposRequest) (*repov1.ListReposResponse, error) {
+	query := &model.RepoListQuery{
+		OwnerID:       req.GetOwnerId(),
+		NamePrefix:    req.GetNamePrefix(),
+		CreatedAfter:  fromTimestamp(req.GetCreatedAfter()),
+		CreatedBefore: fromTimestamp(req.GetCreatedBefore()),
+		UpdatedAfter:  fromTimestamp(req.GetUpdatedAfter()),
+		UpdatedBefore: fromTimestamp(req.GetUpdatedBefore()),
+		Archived:      req.Archived,
+		Limit:         int(req.GetPageSize()),
+		Cursor:        req.GetPageToken(),
+	}
+	if err := setOrderBy(query, req.GetOrderBy()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	page, err := s.Service.List(ctx, query)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return pageToProto(page), nil
+}
+
+// setOrderBy reads a sort key optionally followed by "desc" into query
+func setOrderBy(query *model.RepoListQuery, orderBy string) error {
+	fields := strings.Fields(orderBy)
+	switch {
+	case len(fields) == 0:
+	case len(fields) == 1:
+		query.SortBy = fields[0]
+	case len(fields) == 2 && strings.EqualFold(fields[1], "desc"):
+		query.SortBy = fields[0]
+		query.Descending = true
+	default:
+		return repoerr.Validation(repoerr.Violation{Field: "order_by", Message: "must be a sort key optionally followed by desc"})
+	}
+
+	return nil
+}
+
+func pageToProto(page *model.RepoPage) *repov1.ListReposResponse {
+	resp := &repov1.ListReposResponse{NextPageToken: page.NextCursor}
+	for _, repo := range page.Repos {
+		resp.Repos = append(resp.Repos, toProto(repo))
+	}
+
+	return resp
+}
+
+func toProto(repo *model.PrivateRepoModel) *repov1.Repo {
+	message := &repov1.Repo{
+		Id:             repo.ID.Hex(),
+		Name:           repo.Name,
+		OwnerId:        repo.OwnerID,
+		Description:    repo.Description,
+		CreatedAt:      timestamppb.New(repo.CreatedAt),
+		UpdatedAt:      timestamppb.New(repo.UpdatedAt),
+		Version:        repo.Version,
+		Visibility:     repo.EffectiveVisibility(),
+		OwnerType:      repo.EffectiveOwnerType(),
+		PreviousNames:  repo.PreviousNames,
+		RedirectedFrom: repo.RedirectedFrom,
+		NetworkId:      repo.NetworkID().Hex(),
+		ForksCount:     repo.ForksCount,
+		StarsCount:     repo.StarsCount,
+		WatchersCount:  repo.WatchersCount,
+		Topics:         repo.Topics,
+		Properties:     repo.Properties,
+		IsTemplate:     repo.IsTemplate,
+	}
+	if repo.TemplateID != nil {
+		message.TemplateId = repo.TemplateID.Hex()
+	}
+	if repo.ParentID != nil {
+		message.ParentId = repo.ParentID.Hex()
+	}
+	if repo.ArchivedAt != nil {
+		message.ArchivedAt = timestamppb.New(*repo.ArchivedAt)
+	}
+
+	return message
+}
+
+func fromTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
@@ -0,0 +1,549 @@
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	repogrpc "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/grpc"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public/proto/repov1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type RepoServiceMock struct {
+	mock.Mock
+}
+
+func (s *RepoServiceMock) Create(ctx context.Context, repo *public_repo.CreateRepoModel) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, repo)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) FindById(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) FindByName(ctx context.Context, name string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, name)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) FindByFullName(ctx context.Context, fullName string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, fullName)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) FindByFindByIdentifier(ctx context.Context, identifier string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, identifier)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Update(ctx context.Context, repo *model.PrivateRepoModel) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, repo)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Patch(ctx context.Context, id string, patch *model.RepoPatch) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id, patch)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) ValidateName(ctx context.Context, name string) (string, error) {
+	args := s.Called(ctx, name)
+	return args.String(0), args.Error(1)
+}
+
+func (s *RepoServiceMock) SetVisibility(ctx context.Context, id string, visibility string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id, visibility)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Rename(ctx context.Context, id string, name string, reuseName bool) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id, name, reuseName)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Transfer(ctx context.Context, id string, transfer *model.RepoTransfer) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id, transfer)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Delete(ctx context.Context, repo *model.PrivateRepoModel) error {
+	args := s.Called(ctx, repo)
+	return args.Error(0)
+}
+
+func (s *RepoServiceMock) Restore(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Archive(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Unarchive(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) List(ctx context.Context, query *model.RepoListQuery) (*model.RepoPage, error) {
+	args := s.Called(ctx, query)
+	return args.Get(0).(*model.RepoPage), args.Error(1)
+}
+
+func newRepo() *model.PrivateRepoModel {
+	return &model.PrivateRepoModel{
+		ID:          primitive.NewObjectID(),
+		Name:        "test",
+		OwnerID:     primitive.NewObjectID().Hex(),
+		Description: "test",
+	}
+}
+
+func TestCreateRepo_Success(t *testing.T) {
+	ctx := context.TODO()
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+
+	serviceMock.On("Create", ctx, &public_repo.CreateRepoModel{Name: "test"}).Return(repo, nil)
+
+	resp, err := repogrpc.NewRepoServer(serviceMock).CreateRepo(ctx, &repov1.CreateRepoRequest{Name: "test"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, repo.ID.Hex(), resp.GetId())
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCreateRepo_FromTemplate(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	templateID := primitive.NewObjectID()
+	repo := newRepo()
+	repo.TemplateID = &templateID
+	repo.Topics = []string{"go"}
+
+	serviceMock.On("Create", mock.Anything, &public_repo.CreateRepoModel{
+		Name:       "test",
+		Template:   "owner/template",
+		Topics:     []string{"go"},
+		Properties: map[string]string{"team": "platform"},
+	}).Return(repo, nil)
+
+	resp, err := repogrpc.NewRepoServer(serviceMock).CreateRepo(context.TODO(), &repov1.CreateRepoRequest{
+		Name:       "test",
+		Template:   "owner/template",
+		Topics:     []string{"go"},
+		Properties: map[string]string{"team": "platform"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, templateID.Hex(), resp.GetTemplateId())
+	assert.Equal(t, []string{"go"}, resp.GetTopics())
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCreateRepo_Error_MissingName(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+
+	_, err := repogrpc.NewRepoServer(serviceMock).CreateRepo(context.TODO(), &repov1.CreateRepoRequest{})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCreateRepo_Error_Duplicate(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+
+	duplicate := repoerr.Conflict("repo already exists")
+	serviceMock.On("Create", mock.Anything, mock.Anything).Return((*model.PrivateRepoModel)(nil), duplicate)
+
+	_, err := repogrpc.NewRepoServer(serviceMock).CreateRepo(context.TODO(), &repov1.CreateRepoRequest{Name: "test"})
+
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGetRepo_ByIdentifier(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+
+	serviceMock.On("FindByFindByIdentifier", mock.Anything, "test").Return(repo, nil)
+
+	resp, err := repogrpc.NewRepoServer(serviceMock).GetRepo(context.TODO(), &repov1.GetRepoRequest{
+		Lookup: &repov1.GetRepoRequest_Identifier{Identifier: "test"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, repo.Name, resp.GetName())
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGetRepo_ByFullName(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+
+	serviceMock.On("FindByFullName", mock.Anything, repo.OwnerID+"/test").Return(repo, nil)
+
+	resp, err := repogrpc.NewRepoServer(serviceMock).GetRepo(context.TODO(), &repov1.GetRepoRequest{
+		Lookup: &repov1.GetRepoRequest_FullName{FullName: repo.OwnerID + "/test"},
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, repo.OwnerID, resp.GetOwnerId())
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGetRepo_Error_NotFound(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	id := primitive.NewObjectID().Hex()
+
+	serviceMock.On("FindById", mock.Anything, id).Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
+
+	_, err := repogrpc.NewRepoServer(serviceMock).GetRepo(context.TODO(), &repov1.GetRepoRequest{
+		Lookup: &repov1.GetRepoRequest_Id{Id: id},
+	})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGetRepo_Error_MissingLookup(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+
+	_, err := repogrpc.NewRepoServer(serviceMock).GetRepo(context.TODO(), &repov1.GetRepoRequest{})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateRepo_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+
+	serviceMock.On("Patch", mock.Anything, repo.ID.Hex(), mock.MatchedBy(func(patch *model.RepoPatch) bool {
+		return *patch.Name == "renamed" && *patch.Description == ""
+	})).Return(repo, nil)
+
+	_, err := repogrpc.NewRepoServer(serviceMock).UpdateRepo(context.TODO(), &repov1.UpdateRepoRequest{
+		Repo: &repov1.Repo{Id: repo.ID.Hex(), Name: "renamed"},
+	})
+
+	assert.Nil(t, err)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUpdateRepo_FieldMask(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+
+	serviceMock.On("Patch", mock.Anything, repo.ID.Hex(), mock.MatchedBy(func(patch *model.RepoPatch) bool {
+		return patch.Name == nil && *patch.Description == "patched"
+	})).Return(repo, nil)
+
+	_, err := repogrpc.NewRepoServer(serviceMock).UpdateRepo(context.TODO(), &repov1.UpdateRepoRequest{
+		Repo:       &repov1.Repo{Id: repo.ID.Hex(), Description: "patched"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"description"}},
+	})
+
+	assert.Nil(t, err)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUpdateRepo_TemplateFields(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+	isTemplate := true
+
+	serviceMock.On("Patch", mock.Anything, repo.ID.Hex(), &model.RepoPatch{
+		Topics:     &[]string{"go"},
+		Properties: &map[string]string{},
+		IsTemplate: &isTemplate,
+	}).Return(repo, nil)
+
+	_, err := repogrpc.NewRepoServer(serviceMock).UpdateRepo(context.TODO(), &repov1.UpdateRepoRequest{
+		Repo:       &repov1.Repo{Id: repo.ID.Hex(), Topics: []string{"go"}, IsTemplate: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"topics", "properties", "is_template"}},
+	})
+
+	assert.Nil(t, err)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUpdateRepo_Error_ImmutableField(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+
+	_, err := repogrpc.NewRepoServer(serviceMock).UpdateRepo(context.TODO(), &repov1.UpdateRepoRequest{
+		Repo:       &repov1.Repo{Id: repo.ID.Hex(), OwnerId: "someone-else"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"owner_id"}},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestDeleteRepo_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+
+	serviceMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+	serviceMock.On("Delete", mock.Anything, repo).Return(nil)
+
+	_, err := repogrpc.NewRepoServer(serviceMock).DeleteRepo(context.TODO(), &repov1.DeleteRepoRequest{Id: repo.ID.Hex()})
+
+	assert.Nil(t, err)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestDeleteRepo_Error_InvalidID(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+
+	serviceMock.On("FindById", mock.Anything, "invalid").Return((*model.PrivateRepoModel)(nil), repoerr.New(repoerr.ErrInvalidID, "invalid repo id"))
+
+	_, err := repogrpc.NewRepoServer(serviceMock).DeleteRepo(context.TODO(), &repov1.DeleteRepoRequest{Id: "invalid"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRestoreRepo_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+
+	serviceMock.On("Restore", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	response, err := repogrpc.NewRepoServer(serviceMock).RestoreRepo(context.TODO(), &repov1.RestoreRepoRequest{Id: repo.ID.Hex()})
+
+	assert.Nil(t, err)
+	assert.Equal(t, repo.ID.Hex(), response.GetId())
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRestoreRepo_Error_NotFound(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	id := primitive.NewObjectID().Hex()
+
+	serviceMock.On("Restore", mock.Anything, id).Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
+
+	_, err := repogrpc.NewRepoServer(serviceMock).RestoreRepo(context.TODO(), &repov1.RestoreRepoRequest{Id: id})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGetRepo_Error_Unavailable(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+
+	serviceMock.On("FindByName", mock.Anything, "test").Return((*model.PrivateRepoModel)(nil), repoerr.Wrap(repoerr.ErrUnavailable, errors.New("connection reset"), "repository unavailable"))
+
+	_, err := repogrpc.NewRepoServer(serviceMock).GetRepo(context.TODO(), &repov1.GetRepoRequest{
+		Lookup: &repov1.GetRepoRequest_Name{Name: "test"},
+	})
+
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.NotContains(t, err.Error(), "connection reset")
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCreateRepo_Error_Validation(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+
+	serviceMock.On("Create", mock.Anything, mock.Anything).Return((*model.PrivateRepoModel)(nil), repoerr.Validation(repoerr.Violation{Field: "name", Message: "is too long"}))
+
+	_, err := repogrpc.NewRepoServer(serviceMock).CreateRepo(context.TODO(), &repov1.CreateRepoRequest{Name: "test"})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Len(t, st.Details(), 1)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestListRepos_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+
+	serviceMock.On("List", mock.Anything, &model.RepoListQuery{
+		OwnerID:    repo.OwnerID,
+		SortBy:     model.SortByUpdatedAt,
+		Descending: true,
+		Limit:      10,
+		Cursor:     "token",
+	}).Return(&model.RepoPage{Repos: []*model.PrivateRepoModel{repo}, NextCursor: "next"}, nil)
+
+	resp, err := repogrpc.NewRepoServer(serviceMock).ListRepos(context.TODO(), &repov1.ListReposRequest{
+		OwnerId:   repo.OwnerID,
+		OrderBy:   "updated_at desc",
+		PageSize:  10,
+		PageToken: "token",
+	})
+
+	assert.Nil(t, err)
+	assert.Len(t, resp.GetRepos(), 1)
+	assert.Equal(t, "next", resp.GetNextPageToken())
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestListRepos_Error_InvalidOrderBy(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+
+	_, err := repogrpc.NewRepoServer(serviceMock).ListRepos(context.TODO(), &repov1.ListReposRequest{OrderBy: "name sideways"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestListRepos_Archived(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	archived := true
+
+	serviceMock.On("List", mock.Anything, &model.RepoListQuery{Archived: &archived}).Return(&model.RepoPage{}, nil)
+
+	_, err := repogrpc.NewRepoServer(serviceMock).ListRepos(context.TODO(), &repov1.ListReposRequest{Archived: &archived})
+
+	assert.Nil(t, err)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestArchiveRepo_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+	archivedAt := time.Now()
+	repo.ArchivedAt = &archivedAt
+
+	serviceMock.On("Archive", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	response, err := repogrpc.NewRepoServer(serviceMock).ArchiveRepo(context.TODO(), &repov1.ArchiveRepoRequest{Id: repo.ID.Hex()})
+
+	assert.Nil(t, err)
+	assert.True(t, response.GetArchivedAt().AsTime().Equal(archivedAt))
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUnarchiveRepo_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+
+	serviceMock.On("Unarchive", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	response, err := repogrpc.NewRepoServer(serviceMock).UnarchiveRepo(context.TODO(), &repov1.UnarchiveRepoRequest{Id: repo.ID.Hex()})
+
+	assert.Nil(t, err)
+	assert.Nil(t, response.GetArchivedAt())
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUpdateRepo_Error_Archived(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+
+	serviceMock.On("Patch", mock.Anything, repo.ID.Hex(), mock.Anything).Return((*model.PrivateRepoModel)(nil), repoerr.ReadOnly("repo is archived and read-only, unarchive it first"))
+
+	_, err := repogrpc.NewRepoServer(serviceMock).UpdateRepo(context.TODO(), &repov1.UpdateRepoRequest{
+		Repo: &repov1.Repo{Id: repo.ID.Hex(), Name: "test", Description: "new"},
+	})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUpdateRepo_Error_StaleVersion(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+
+	serviceMock.On("Patch", mock.Anything, repo.ID.Hex(), mock.MatchedBy(func(patch *model.RepoPatch) bool {
+		return patch.Version == 2
+	})).Return((*model.PrivateRepoModel)(nil), repoerr.VersionMismatch())
+
+	_, err := repogrpc.NewRepoServer(serviceMock).UpdateRepo(context.TODO(), &repov1.UpdateRepoRequest{
+		Repo: &repov1.Repo{Id: repo.ID.Hex(), Name: "renamed", Version: 2},
+	})
+
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestSetRepoVisibility_Error_NotOwner(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	id := primitive.NewObjectID().Hex()
+
+	serviceMock.On("SetVisibility", mock.Anything, id, "private").Return((*model.PrivateRepoModel)(nil), repoerr.PermissionDenied("only the owner can change the visibility of a repo"))
+
+	_, err := repogrpc.NewRepoServer(serviceMock).SetRepoVisibility(context.TODO(), &repov1.SetRepoVisibilityRequest{Id: id, Visibility: "private"})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTransferRepo_Error_MissingOwner(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+
+	_, err := repogrpc.NewRepoServer(serviceMock).TransferRepo(context.TODO(), &repov1.TransferRepoRequest{Id: primitive.NewObjectID().Hex()})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTransferRepo_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+
+	serviceMock.On("Transfer", mock.Anything, repo.ID.Hex(), &model.RepoTransfer{NewOwner: "someone", NewName: "renamed"}).Return(repo, nil)
+
+	resp, err := repogrpc.NewRepoServer(serviceMock).TransferRepo(context.TODO(), &repov1.TransferRepoRequest{Id: repo.ID.Hex(), NewOwner: "someone", NewName: "renamed"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, repo.ID.Hex(), resp.GetId())
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRenameRepo_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo()
+	repo.PreviousNames = []string{"old"}
+
+	serviceMock.On("Rename", mock.Anything, repo.ID.Hex(), "renamed", false).Return(repo, nil)
+
+	resp, err := repogrpc.NewRepoServer(serviceMock).RenameRepo(context.TODO(), &repov1.RenameRepoRequest{Id: repo.ID.Hex(), Name: "renamed"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"old"}, resp.GetPreviousNames())
+
+	serviceMock.AssertExpectations(t)
+}
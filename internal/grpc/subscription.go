@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public/proto/repov1"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SubscriptionServer struct {
+	repov1.UnimplementedSubscriptionServiceServer
+	Service service.SubscriptionService
+}
+
+func NewSubscriptionServer(service service.SubscriptionService) *SubscriptionServer {
+	return &SubscriptionServer{
+		Service: service,
+	}
+}
+
+func (s *SubscriptionServer) StarRepo(ctx context.Context, req *repov1.SubscriptionRequest) (*emptypb.Empty, error) {
+	return changeSubscription(ctx, req, s.Service.Star)
+}
+
+func (s *SubscriptionServer) UnstarRepo(ctx context.Context, req *repov1.SubscriptionRequest) (*emptypb.Empty, error) {
+	return changeSubscription(ctx, req, s.Service.Unstar)
+}
+
+func (s *SubscriptionServer) WatchRepo(ctx context.Context, req *repov1.SubscriptionRequest) (*emptypb.Empty, error) {
+	return changeSubscription(ctx, req, s.Service.Watch)
+}
+
+func (s *SubscriptionServer) UnwatchRepo(ctx context.Context, req *repov1.SubscriptionRequest) (*emptypb.Empty, error) {
+	return changeSubscription(ctx, req, s.Service.Unwatch)
+}
+
+func (s *SubscriptionServer) ListStargazers(ctx context.Context, req *repov1.ListSubscribersRequest) (*repov1.ListSubscribersResponse, error) {
+	return listSubscribers(ctx, req, s.Service.ListStargazers)
+}
+
+func (s *SubscriptionServer) ListWatchers(ctx context.Context, req *repov1.ListSubscribersRequest) (*repov1.ListSubscribersResponse, error) {
+	return listSubscribers(ctx, req, s.Service.ListWatchers)
+}
+
+func (s *SubscriptionServer) ListStarredRepos(ctx context.Context, req *repov1.ListStarredReposRequest) (*repov1.ListReposResponse, error) {
+	page, err := s.Service.ListStarred(ctx, req.GetUserId(), &model.SubscriptionQuery{
+		Limit:  int(req.GetPageSize()),
+		Cursor: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return pageToProto(page), nil
+}
+
+func changeSubscription(ctx context.Context, req *repov1.SubscriptionRequest, change func(ctx context.Context, id string) error) (*emptypb.Empty, error) {
+	if err := change(ctx, req.GetRepoId()); err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func listSubscribers(ctx context.Context, req *repov1.ListSubscribersRequest, list func(ctx context.Context, id string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error)) (*repov1.ListSubscribersResponse, error) {
+	page, err := list(ctx, req.GetRepoId(), &model.SubscriptionQuery{
+		Limit:  int(req.GetPageSize()),
+		Cursor: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &repov1.ListSubscribersResponse{NextPageToken: page.NextCursor}
+	for _, subscription := range page.Subscriptions {
+		resp.Subscribers = append(resp.Subscribers, &repov1.Subscriber{
+			UserId:    subscription.UserID,
+			CreatedAt: timestamppb.New(subscription.CreatedAt),
+		})
+	}
+
+	return resp, nil
+}
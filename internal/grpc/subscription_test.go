@@ -0,0 +1,118 @@
+package grpc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	repogrpc "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/grpc"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public/proto/repov1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SubscriptionServiceMock struct {
+	mock.Mock
+}
+
+func (s *SubscriptionServiceMock) Star(ctx context.Context, id string) error {
+	return s.Called(ctx, id).Error(0)
+}
+
+func (s *SubscriptionServiceMock) Unstar(ctx context.Context, id string) error {
+	return s.Called(ctx, id).Error(0)
+}
+
+func (s *SubscriptionServiceMock) Watch(ctx context.Context, id string) error {
+	return s.Called(ctx, id).Error(0)
+}
+
+func (s *SubscriptionServiceMock) Unwatch(ctx context.Context, id string) error {
+	return s.Called(ctx, id).Error(0)
+}
+
+func (s *SubscriptionServiceMock) ListStargazers(ctx context.Context, id string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error) {
+	args := s.Called(ctx, id, query)
+	return args.Get(0).(*model.SubscriptionPage), args.Error(1)
+}
+
+func (s *SubscriptionServiceMock) ListWatchers(ctx context.Context, id string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error) {
+	args := s.Called(ctx, id, query)
+	return args.Get(0).(*model.SubscriptionPage), args.Error(1)
+}
+
+func (s *SubscriptionServiceMock) ListStarred(ctx context.Context, userID string, query *model.SubscriptionQuery) (*model.RepoPage, error) {
+	args := s.Called(ctx, userID, query)
+	return args.Get(0).(*model.RepoPage), args.Error(1)
+}
+
+func TestStarRepo_Success(t *testing.T) {
+	serviceMock := new(SubscriptionServiceMock)
+	repoID := primitive.NewObjectID()
+
+	serviceMock.On("Star", mock.Anything, repoID.Hex()).Return(nil)
+
+	_, err := repogrpc.NewSubscriptionServer(serviceMock).StarRepo(context.TODO(), &repov1.SubscriptionRequest{RepoId: repoID.Hex()})
+
+	assert.Nil(t, err)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestWatchRepo_Error_Unauthenticated(t *testing.T) {
+	serviceMock := new(SubscriptionServiceMock)
+	repoID := primitive.NewObjectID()
+
+	serviceMock.On("Watch", mock.Anything, repoID.Hex()).Return(repoerr.Unauthenticated("sign in to watch a repo"))
+
+	_, err := repogrpc.NewSubscriptionServer(serviceMock).WatchRepo(context.TODO(), &repov1.SubscriptionRequest{RepoId: repoID.Hex()})
+
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestListWatchers_Success(t *testing.T) {
+	serviceMock := new(SubscriptionServiceMock)
+	repoID := primitive.NewObjectID()
+	createdAt := time.Now().UTC()
+
+	serviceMock.On("ListWatchers", mock.Anything, repoID.Hex(), &model.SubscriptionQuery{Limit: 5, Cursor: "token"}).
+		Return(&model.SubscriptionPage{
+			Subscriptions: []*model.Subscription{{RepoID: repoID, UserID: "user", Kind: model.SubscriptionWatch, CreatedAt: createdAt}},
+			NextCursor:    "next",
+		}, nil)
+
+	resp, err := repogrpc.NewSubscriptionServer(serviceMock).ListWatchers(context.TODO(), &repov1.ListSubscribersRequest{RepoId: repoID.Hex(), PageSize: 5, PageToken: "token"})
+
+	assert.Nil(t, err)
+	assert.Len(t, resp.GetSubscribers(), 1)
+	assert.Equal(t, "user", resp.GetSubscribers()[0].GetUserId())
+	assert.True(t, createdAt.Equal(resp.GetSubscribers()[0].GetCreatedAt().AsTime()))
+	assert.Equal(t, "next", resp.GetNextPageToken())
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestListStarredRepos_Success(t *testing.T) {
+	serviceMock := new(SubscriptionServiceMock)
+	repo := newRepo()
+	repo.StarsCount, repo.WatchersCount = 3, 2
+
+	serviceMock.On("ListStarred", mock.Anything, "user", &model.SubscriptionQuery{Limit: 10, Cursor: "abc"}).
+		Return(&model.RepoPage{Repos: []*model.PrivateRepoModel{repo}}, nil)
+
+	resp, err := repogrpc.NewSubscriptionServer(serviceMock).ListStarredRepos(context.TODO(), &repov1.ListStarredReposRequest{UserId: "user", PageSize: 10, PageToken: "abc"})
+
+	assert.Nil(t, err)
+	assert.Len(t, resp.GetRepos(), 1)
+	assert.Equal(t, int64(3), resp.GetRepos()[0].GetStarsCount())
+	assert.Equal(t, int64(2), resp.GetRepos()[0].GetWatchersCount())
+
+	serviceMock.AssertExpectations(t)
+}
@@ -0,0 +1,31 @@
+package identity
+
+import "context"
+
+// Caller is the authenticated principal behind a request
+type Caller struct {
+	ID    string
+	Roles []string // Platform wide roles granted by the token, e.g. "admin"
+}
+
+// HasRole reports whether the token granted the caller role
+func (c Caller) HasRole(role string) bool {
+	for _, granted := range c.Roles {
+		if granted == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+type contextKey struct{}
+
+func NewContext(ctx context.Context, caller Caller) context.Context {
+	return context.WithValue(ctx, contextKey{}, caller)
+}
+
+func FromContext(ctx context.Context) (Caller, bool) {
+	caller, ok := ctx.Value(contextKey{}).(Caller)
+	return caller, ok
+}
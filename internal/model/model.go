@@ -8,19 +8,468 @@ import (
 )
 
 type PrivateRepoModel struct {
-	ID          primitive.ObjectID `json:"id" bson:"_id,omitempty"`
-	Name        string             `json:"name" bson:"name"`
-	OwnerID     string             `json:"ownerId" bson:"owner_id"`
-	Description string             `json:"description" bson:"description"`
-	CreatedAt   time.Time          `json:"created_at" bson:"created_at"`
-	UpdatedAt   time.Time          `json:"updated_at" bson:"updated_at"`
+	ID             primitive.ObjectID  `json:"id" bson:"_id,omitempty"`
+	Name           string              `json:"name" bson:"name"`
+	OwnerID        string              `json:"ownerId" bson:"owner_id"`
+	Description    string              `json:"description" bson:"description"`
+	CreatedAt      time.Time           `json:"created_at" bson:"created_at"`
+	UpdatedAt      time.Time           `json:"updated_at" bson:"updated_at"`
+	Version        int64               `json:"version" bson:"version"`      // Bumped on every update, used for compare-and-swap
+	Skeleton       string              `json:"-" bson:"skeleton,omitempty"` // Confusable-insensitive form of Name, see naming.Skeleton
+	Visibility     string              `json:"visibility" bson:"visibility,omitempty"`
+	OwnerType      string              `json:"ownerType" bson:"owner_type,omitempty"` // One of the Owner* types, OwnerID is an organization's ID for OwnerOrganization
+	PreviousNames  []string            `json:"previousNames,omitempty" bson:"previous_names,omitempty"`
+	RedirectedFrom string              `json:"-" bson:"-"`                                        // Former address the repo was looked up by, set by the service
+	DeletedAt      *time.Time          `json:"deletedAt,omitempty" bson:"deleted_at,omitempty"`   // Set while the repo is in the trash
+	ArchivedAt     *time.Time          `json:"archivedAt,omitempty" bson:"archived_at,omitempty"` // Set while the repo is archived and read-only
+	ParentID       *primitive.ObjectID `json:"parentId,omitempty" bson:"parent_id,omitempty"`     // Repo this one was forked from
+	RootID         *primitive.ObjectID `json:"rootId,omitempty" bson:"root_id,omitempty"`         // Repo the fork network started with, nil for the root itself
+	ForksCount     int64               `json:"forksCount" bson:"forks_count"`                     // Direct forks that are not in the trash, only changed through AddForks and SetForks
+	StarsCount     int64               `json:"starsCount" bson:"stars_count"`                     // Users who starred the repo, only changed through AddStars and SetStars
+	WatchersCount  int64               `json:"watchersCount" bson:"watchers_count"`               // Users watching the repo, only changed through AddWatchers and SetWatchers
+	Topics         []string            `json:"topics,omitempty" bson:"topics,omitempty"`          // Lowercase labels for discovery
+	Properties     map[string]string   `json:"properties,omitempty" bson:"properties,omitempty"`  // Custom properties, left out of the public model
+	IsTemplate     bool                `json:"isTemplate" bson:"is_template,omitempty"`           // New repos may be created from it
+	TemplateID     *primitive.ObjectID `json:"templateId,omitempty" bson:"template_id,omitempty"` // Template the repo was created from
+}
+
+const (
+	OwnerUser         = "user"
+	OwnerOrganization = "organization"
+)
+
+// EffectiveOwnerType treats repos stored before organizations existed as owned by a user
+func (privateRepoModel *PrivateRepoModel) EffectiveOwnerType() string {
+	if privateRepoModel.OwnerType == "" {
+		return OwnerUser
+	}
+
+	return privateRepoModel.OwnerType
+}
+
+// OrganizationID returns the ID of the organization owning the repo, ok is false
+// for repos owned by a user
+func (privateRepoModel *PrivateRepoModel) OrganizationID() (id primitive.ObjectID, ok bool) {
+	if privateRepoModel.EffectiveOwnerType() != OwnerOrganization {
+		return primitive.NilObjectID, false
+	}
+
+	id, err := primitive.ObjectIDFromHex(privateRepoModel.OwnerID)
+	return id, err == nil
+}
+
+const (
+	VisibilityPublic   = "public"   // Anyone, including anonymous callers
+	VisibilityInternal = "internal" // Any authenticated caller
+	VisibilityPrivate  = "private"  // Members only
+)
+
+// EffectiveVisibility treats repos stored before visibility existed as public
+func (privateRepoModel *PrivateRepoModel) EffectiveVisibility() string {
+	if privateRepoModel.Visibility == "" {
+		return VisibilityPublic
+	}
+
+	return privateRepoModel.Visibility
+}
+
+// HadName reports whether the repo was ever called name before its current name
+func (privateRepoModel *PrivateRepoModel) HadName(name string) bool {
+	for _, previous := range privateRepoModel.PreviousNames {
+		if previous == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Trashed reports whether the repo was deleted and waits in the trash to be
+// restored or purged
+func (privateRepoModel *PrivateRepoModel) Trashed() bool {
+	return privateRepoModel.DeletedAt != nil
+}
+
+// Archived reports whether the repo is archived, which makes it read-only
+func (privateRepoModel *PrivateRepoModel) Archived() bool {
+	return privateRepoModel.ArchivedAt != nil
+}
+
+// IsFork reports whether the repo was forked from another one
+func (privateRepoModel *PrivateRepoModel) IsFork() bool {
+	return privateRepoModel.ParentID != nil
+}
+
+// NetworkID identifies the fork network of the repo by the repo it started with.
+// The ID stays the same after that repo is purged.
+func (privateRepoModel *PrivateRepoModel) NetworkID() primitive.ObjectID {
+	if privateRepoModel.RootID != nil {
+		return *privateRepoModel.RootID
+	}
+
+	return privateRepoModel.ID
 }
 
 // To PublicRepoModel
 func (privateRepoModel *PrivateRepoModel) ToPublicRepoModel() *repo.PublicRepoModel {
 	return &repo.PublicRepoModel{
-		ID:        privateRepoModel.ID,
-		Name:      privateRepoModel.Name,
-		CreatedAt: privateRepoModel.CreatedAt,
+		ID:            privateRepoModel.ID,
+		Name:          privateRepoModel.Name,
+		CreatedAt:     privateRepoModel.CreatedAt,
+		UpdatedAt:     privateRepoModel.UpdatedAt,
+		Visibility:    privateRepoModel.EffectiveVisibility(),
+		Archived:      privateRepoModel.Archived(),
+		ParentID:      privateRepoModel.ParentID,
+		ForksCount:    privateRepoModel.ForksCount,
+		StarsCount:    privateRepoModel.StarsCount,
+		WatchersCount: privateRepoModel.WatchersCount,
+		Topics:        privateRepoModel.Topics,
+		IsTemplate:    privateRepoModel.IsTemplate,
+		TemplateID:    privateRepoModel.TemplateID,
+	}
+}
+
+// RepoPatch is a partial update of a repo, nil fields are left untouched
+type RepoPatch struct {
+	Name         *string
+	Skeleton     string // Set by the service along with Name
+	Description  *string
+	Visibility   *string
+	OwnerID      *string
+	OwnerType    string             // Set by the service along with OwnerID
+	PreviousName string             // Set by the service along with Name, added to PreviousNames
+	ReuseName    bool               // Lets Name take over a former name of another repo of the owner
+	Archived     *bool              // True archives the repo as of UpdatedAt, false unarchives it
+	Topics       *[]string          // Replaces all topics, set by the service to the normalized topics
+	Properties   *map[string]string // Replaces all custom properties
+	IsTemplate   *bool
+	Version      int64     // Expected stored version, 0 skips the check
+	UpdatedAt    time.Time // Set by the service
+}
+
+// Apply copies the patched fields onto repo
+func (p *RepoPatch) Apply(repo *PrivateRepoModel) {
+	if p.Name != nil {
+		repo.Name = *p.Name
+		repo.Skeleton = p.Skeleton
+	}
+	if p.PreviousName != "" && !repo.HadName(p.PreviousName) {
+		repo.PreviousNames = append(append([]string{}, repo.PreviousNames...), p.PreviousName)
+	}
+	if p.Description != nil {
+		repo.Description = *p.Description
+	}
+	if p.Visibility != nil {
+		repo.Visibility = *p.Visibility
+	}
+	if p.OwnerID != nil {
+		repo.OwnerID = *p.OwnerID
+		repo.OwnerType = p.OwnerType
+	}
+	if p.Topics != nil {
+		repo.Topics = append([]string(nil), *p.Topics...)
+	}
+	if p.Properties != nil {
+		repo.Properties = make(map[string]string, len(*p.Properties))
+		for key, value := range *p.Properties {
+			repo.Properties[key] = value
+		}
+	}
+	if p.IsTemplate != nil {
+		repo.IsTemplate = *p.IsTemplate
+	}
+	if p.Archived != nil {
+		repo.ArchivedAt = nil
+		if *p.Archived {
+			archivedAt := p.UpdatedAt
+			repo.ArchivedAt = &archivedAt
+		}
+	}
+	repo.UpdatedAt = p.UpdatedAt
+}
+
+const (
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+	SortByName      = "name"
+	SortByStars     = "stars_count"
+	SortByWatchers  = "watchers_count"
+)
+
+// RepoListQuery filters and pages through repos. Zero values mean "no filter".
+type RepoListQuery struct {
+	OwnerID       string
+	Name          string               // Exact name, as stored
+	NamePrefix    string               // Matched against the stored name, normalized by the service
+	CreatedAfter  time.Time            // Inclusive
+	CreatedBefore time.Time            // Exclusive
+	UpdatedAfter  time.Time            // Inclusive
+	UpdatedBefore time.Time            // Exclusive
+	Archived      *bool                // Only archived repos if true, only the others if false
+	ParentID      primitive.ObjectID   // Only the direct forks of this repo
+	NetworkID     primitive.ObjectID   // Only the repos of this fork network, see PrivateRepoModel.NetworkID
+	IDs           []primitive.ObjectID // Only these repos, ignored when empty
+	SortBy        string               // One of the SortBy* keys
+	Descending    bool
+	Limit         int         // Page size, 0 returns everything
+	Cursor        string      // Opaque cursor from a previous RepoPage
+	Access        *RepoAccess // Nil lists every repo
+}
+
+// RepoAccess limits a listing to the repos a caller may see
+type RepoAccess struct {
+	Visibilities []string             // Visible whoever owns them
+	MemberID     string               // Owner whose repos are visible whatever their visibility, empty for anonymous callers
+	RepoIDs      []primitive.ObjectID // Repos the caller collaborates on, visible whatever their visibility
+	OrgIDs       []string             // Organizations the caller belongs to, their repos are visible whatever their visibility
+}
+
+// Allows reports whether the caller behind the access may see repo
+func (a *RepoAccess) Allows(repo *PrivateRepoModel) bool {
+	if a.MemberID != "" && repo.OwnerID == a.MemberID {
+		return true
+	}
+
+	for _, id := range a.RepoIDs {
+		if repo.ID == id {
+			return true
+		}
+	}
+
+	if _, ok := repo.OrganizationID(); ok {
+		for _, id := range a.OrgIDs {
+			if repo.OwnerID == id {
+				return true
+			}
+		}
+	}
+
+	for _, visibility := range a.Visibilities {
+		if repo.EffectiveVisibility() == visibility {
+			return true
+		}
+	}
+
+	return false
+}
+
+type RepoPage struct {
+	Repos      []*PrivateRepoModel
+	NextCursor string // Empty on the last page
+}
+
+const (
+	AuditVisibilityChanged   = "visibility.changed"
+	AuditCollaboratorAdded   = "collaborator.added"
+	AuditCollaboratorRemoved = "collaborator.removed"
+	AuditCollaboratorUpdated = "collaborator.role_changed"
+	AuditTeamGranted         = "team.granted"
+	AuditTeamRevoked         = "team.revoked"
+	AuditRepoTransferred     = "repo.transferred"
+	AuditRepoRenamed         = "repo.renamed"
+	AuditRepoDeleted         = "repo.deleted"
+	AuditRepoRestored        = "repo.restored"
+	AuditRepoArchived        = "repo.archived"
+	AuditRepoUnarchived      = "repo.unarchived"
+)
+
+// AuditEntry records a sensitive change to a repo
+type AuditEntry struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	RepoID    primitive.ObjectID `json:"repoId" bson:"repo_id"`
+	ActorID   string             `json:"actorId" bson:"actor_id"`
+	Action    string             `json:"action" bson:"action"` // One of the Audit* actions
+	Details   map[string]string  `json:"details,omitempty" bson:"details,omitempty"`
+	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+}
+
+// RepoTransfer moves a repo to another owner
+type RepoTransfer struct {
+	NewOwner          string // Organization login or user ID
+	NewName           string // Empty keeps the current name
+	KeepCollaborators bool   // Collaborators are removed unless set
+	ReuseName         bool   // Lets the name take over a former name of another repo of the new owner
+}
+
+// RepoFork copies a repo into a new one linked to it
+type RepoFork struct {
+	Name         string // Empty keeps the name of the parent
+	Organization string // Login of the organization to fork into, empty forks into the caller's repos
+}
+
+// Redirect keeps a former "owner/name" address of a repo resolving to it
+type Redirect struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	OwnerID   string             `json:"ownerId" bson:"owner_id"`
+	Name      string             `json:"name" bson:"name"`
+	RepoID    primitive.ObjectID `json:"repoId" bson:"repo_id"`
+	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+}
+
+const (
+	SubscriptionStar  = "star"  // Bookmarks a repo and shows appreciation, counted in StarsCount
+	SubscriptionWatch = "watch" // Follows the activity of a repo, counted in WatchersCount
+)
+
+// Subscription records that a user starred or watches a repo
+type Subscription struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	RepoID    primitive.ObjectID `json:"repoId" bson:"repo_id"`
+	UserID    string             `json:"userId" bson:"user_id"`
+	Kind      string             `json:"kind" bson:"kind"` // One of the Subscription* kinds
+	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+}
+
+func (s *Subscription) ToPublicSubscriptionModel() repo.SubscriptionModel {
+	return repo.SubscriptionModel{
+		UserID:    s.UserID,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+// SubscriptionQuery pages through the subscriptions of a repo, newest first
+type SubscriptionQuery struct {
+	Limit  int    // Page size, 0 returns everything
+	Cursor string // Opaque cursor from a previous SubscriptionPage
+}
+
+type SubscriptionPage struct {
+	Subscriptions []*Subscription
+	NextCursor    string // Empty on the last page
+}
+
+// Collaborator roles, each includes the permissions of the ones before it
+const (
+	CollaboratorRead     = "read"
+	CollaboratorTriage   = "triage"
+	CollaboratorWrite    = "write"
+	CollaboratorMaintain = "maintain"
+	CollaboratorAdmin    = "admin"
+)
+
+// CollaboratorRoles lists the roles from least to most privileged
+var CollaboratorRoles = []string{CollaboratorRead, CollaboratorTriage, CollaboratorWrite, CollaboratorMaintain, CollaboratorAdmin}
+
+// Collaborator grants a user other than the owner a role on a repo
+type Collaborator struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	RepoID    primitive.ObjectID `json:"repoId" bson:"repo_id"`
+	UserID    string             `json:"userId" bson:"user_id"`
+	Role      string             `json:"role" bson:"role"` // One of the Collaborator* roles
+	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+func (c *Collaborator) ToPublicCollaboratorModel() repo.CollaboratorModel {
+	return repo.CollaboratorModel{
+		UserID:    c.UserID,
+		Role:      c.Role,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
+
+// Organization roles; owners administer the organization and all of its repos
+const (
+	OrgRoleOwner  = "owner"
+	OrgRoleMember = "member"
+)
+
+// Organization owns repos on behalf of its members
+type Organization struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Login     string             `json:"login" bson:"login"` // Unique, used in place of a user ID in "owner/name"
+	Name      string             `json:"name" bson:"name"`
+	Members   []OrgMember        `json:"members" bson:"members"`
+	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+type OrgMember struct {
+	UserID string `json:"userId" bson:"user_id"`
+	Role   string `json:"role" bson:"role"` // One of the OrgRole* roles
+}
+
+// Member returns the membership of userID, ok is false for non members
+func (o *Organization) Member(userID string) (member OrgMember, ok bool) {
+	for _, member := range o.Members {
+		if member.UserID == userID {
+			return member, true
+		}
+	}
+
+	return OrgMember{}, false
+}
+
+// Team groups members of an organization to grant them a role on several of its repos
+type Team struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	OrgID     primitive.ObjectID `json:"orgId" bson:"org_id"`
+	Slug      string             `json:"slug" bson:"slug"` // Unique within the organization
+	Name      string             `json:"name" bson:"name"`
+	Members   []string           `json:"members" bson:"members"`
+	Repos     []TeamRepo         `json:"repos" bson:"repos"`
+	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at" bson:"updated_at"`
+}
+
+// TeamRepo grants a team one of the Collaborator* roles on a repo
+type TeamRepo struct {
+	RepoID primitive.ObjectID `json:"repoId" bson:"repo_id"`
+	Role   string             `json:"role" bson:"role"`
+}
+
+// RepoRole returns the role the team holds on repoID, ok is false without a grant
+func (t *Team) RepoRole(repoID primitive.ObjectID) (role string, ok bool) {
+	for _, repo := range t.Repos {
+		if repo.RepoID == repoID {
+			return repo.Role, true
+		}
+	}
+
+	return "", false
+}
+
+// HasMember reports whether userID belongs to the team
+func (t *Team) HasMember(userID string) bool {
+	for _, member := range t.Members {
+		if member == userID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (o *Organization) ToPublicOrganizationModel() repo.OrganizationModel {
+	members := make([]repo.OrgMemberModel, 0, len(o.Members))
+	for _, member := range o.Members {
+		members = append(members, repo.OrgMemberModel{UserID: member.UserID, Role: member.Role})
+	}
+
+	return repo.OrganizationModel{
+		ID:        o.ID,
+		Login:     o.Login,
+		Name:      o.Name,
+		Members:   members,
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
+
+func (t *Team) ToPublicTeamModel() repo.TeamModel {
+	repos := make([]repo.TeamRepoModel, 0, len(t.Repos))
+	for _, teamRepo := range t.Repos {
+		repos = append(repos, repo.TeamRepoModel{RepoID: teamRepo.RepoID, Role: teamRepo.Role})
+	}
+
+	return repo.TeamModel{
+		ID:        t.ID,
+		Slug:      t.Slug,
+		Name:      t.Name,
+		Members:   append([]string{}, t.Members...),
+		Repos:     repos,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
 	}
 }
@@ -0,0 +1,35 @@
+package naming_test
+
+import (
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/naming"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	for input, expected := range map[string]string{
+		"My Repo":            "my-repo",
+		"a  --  b":           "a-b",
+		"Café":               "cafe",
+		"Cafe\u0301":         "cafe",
+		"Straße":             "strasse",
+		"Ｆｕｌｌｗｉｄｔｈ":          "fullwidth",
+		"ﬁle":                "file",
+		"Привет мир":         "privet-mir",
+		"λόγος":              "logos",
+		"tab\tseparated":     "tab-separated",
+		"日本":                 "日本",
+		"already-normalized": "already-normalized",
+	} {
+		assert.Equal(t, expected, naming.Normalize(input), input)
+	}
+}
+
+func TestSkeleton(t *testing.T) {
+	assert.Equal(t, naming.Skeleton("paypal"), naming.Skeleton("paypa1"))
+	assert.Equal(t, naming.Skeleton("paypal"), naming.Skeleton("pаypal")) // Cyrillic а
+	assert.Equal(t, naming.Skeleton("modern"), naming.Skeleton("rnodern"))
+	assert.Equal(t, naming.Skeleton("go"), naming.Skeleton("g0"))
+	assert.NotEqual(t, naming.Skeleton("paypal"), naming.Skeleton("paypals"))
+}
@@ -0,0 +1,94 @@
+package naming
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+)
+
+// Policy holds the rules a normalized repo name has to satisfy
+type Policy struct {
+	MinLength         int      `json:"minLength" yaml:"minLength"`
+	MaxLength         int      `json:"maxLength" yaml:"maxLength"`
+	Charset           string   `json:"charset" yaml:"charset"`                     // Every character of a name must be one of these
+	Reserved          []string `json:"reserved" yaml:"reserved"`                   // Names taken by routes or UI pages, compared case-insensitively
+	ForbiddenSuffixes []string `json:"forbiddenSuffixes" yaml:"forbiddenSuffixes"` // Compared case-insensitively
+}
+
+func DefaultPolicy() *Policy {
+	return &Policy{
+		MinLength:         1,
+		MaxLength:         100,
+		Charset:           "abcdefghijklmnopqrstuvwxyz0123456789._-",
+		Reserved:          []string{"new", "settings", "validate-name"},
+		ForbiddenSuffixes: []string{".git", ".atom"},
+	}
+}
+
+// Validate returns a validation error listing every rule name breaks
+func (p *Policy) Validate(name string) error {
+	if name == "" {
+		return repoerr.Validation(violation("is required"))
+	}
+
+	violations := []repoerr.Violation{}
+
+	length := utf8.RuneCountInString(name)
+	if length < p.MinLength {
+		violations = append(violations, violation(fmt.Sprintf("must be at least %d characters", p.MinLength)))
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, violation(fmt.Sprintf("must be at most %d characters", p.MaxLength)))
+	}
+
+	// "/" separates owner and name in full names, no charset may allow it
+	if strings.Contains(name, "/") {
+		violations = append(violations, violation("must not contain /"))
+	}
+	if i := strings.IndexFunc(name, func(r rune) bool { return r != '/' && !strings.ContainsRune(p.Charset, r) }); i >= 0 {
+		r, _ := utf8.DecodeRuneInString(name[i:])
+		violations = append(violations, violation(fmt.Sprintf("must not contain %q", r)))
+	}
+
+	first, _ := utf8.DecodeRuneInString(name)
+	if !isAlphanumeric(first) {
+		violations = append(violations, violation("must start with a letter or digit"))
+	}
+	last, _ := utf8.DecodeLastRuneInString(name)
+	if !isAlphanumeric(last) {
+		violations = append(violations, violation("must end with a letter or digit"))
+	}
+	if strings.Contains(name, "..") {
+		violations = append(violations, violation(`must not contain ".."`))
+	}
+
+	for _, reserved := range p.Reserved {
+		if strings.EqualFold(name, reserved) {
+			violations = append(violations, violation("is reserved"))
+			break
+		}
+	}
+	for _, suffix := range p.ForbiddenSuffixes {
+		if strings.HasSuffix(strings.ToLower(name), strings.ToLower(suffix)) {
+			violations = append(violations, violation(fmt.Sprintf("must not end with %q", suffix)))
+			break
+		}
+	}
+
+	if len(violations) > 0 {
+		return repoerr.Validation(violations...)
+	}
+
+	return nil
+}
+
+func violation(message string) repoerr.Violation {
+	return repoerr.Violation{Field: "name", Message: message}
+}
+
+func isAlphanumeric(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
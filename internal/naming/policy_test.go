@@ -0,0 +1,63 @@
+package naming_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/naming"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate_Valid(t *testing.T) {
+	for _, name := range []string{"a", "my-repo", "repo.js", "snake_case", "v2", "api", "admin", strings.Repeat("a", 100)} {
+		assert.Nil(t, naming.DefaultPolicy().Validate(name), name)
+	}
+}
+
+func TestValidate_Invalid(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		messages []string
+	}{
+		{"", []string{"is required"}},
+		{"../etc", []string{"must not contain /", "must start with a letter or digit", `must not contain ".."`}},
+		{".git", []string{"must start with a letter or digit", `must not end with ".git"`}},
+		{"repo.git", []string{`must not end with ".git"`}},
+		{"-repo", []string{"must start with a letter or digit"}},
+		{"repo-", []string{"must end with a letter or digit"}},
+		{"rocket-🚀", []string{`must not contain '🚀'`, "must end with a letter or digit"}},
+		{"Settings", []string{`must not contain 'S'`, "is reserved"}},
+		{"new", []string{"is reserved"}},
+		{"validate-name", []string{"is reserved"}},
+		{strings.Repeat("a", 5000), []string{"must be at most 100 characters"}},
+	} {
+		err := naming.DefaultPolicy().Validate(tc.name)
+
+		assert.ErrorIs(t, err, repoerr.ErrValidationFailed, tc.name)
+
+		messages := []string{}
+		for _, violation := range repoerr.ViolationsOf(err) {
+			assert.Equal(t, "name", violation.Field)
+			messages = append(messages, violation.Message)
+		}
+		assert.Equal(t, tc.messages, messages, tc.name)
+	}
+}
+
+func TestValidate_CustomPolicy(t *testing.T) {
+	policy := &naming.Policy{
+		MinLength:         3,
+		MaxLength:         5,
+		Charset:           "abc",
+		Reserved:          []string{"abc"},
+		ForbiddenSuffixes: []string{"cc"},
+	}
+
+	assert.Nil(t, policy.Validate("abca"))
+	assert.ErrorIs(t, policy.Validate("ab"), repoerr.ErrValidationFailed)
+	assert.ErrorIs(t, policy.Validate("abcabc"), repoerr.ErrValidationFailed)
+	assert.ErrorIs(t, policy.Validate("abd"), repoerr.ErrValidationFailed)
+	assert.ErrorIs(t, policy.Validate("ABC"), repoerr.ErrValidationFailed)
+	assert.ErrorIs(t, policy.Validate("abcc"), repoerr.ErrValidationFailed)
+}
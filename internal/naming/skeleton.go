@@ -0,0 +1,39 @@
+package naming
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Skeleton maps a name to a canonical form in which visually confusable names are
+// equal, after the idea of the UTS #39 skeleton: "paypa1", "paypal" and "pаypal" with
+// a Cyrillic "а" share one.
+func Skeleton(name string) string {
+	name = strings.ToLower(norm.NFKC.String(name))
+
+	var b strings.Builder
+	for _, r := range name {
+		if prototype, ok := confusables[r]; ok {
+			b.WriteRune(prototype)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return confusableSequences.Replace(b.String())
+}
+
+// confusables maps characters to the prototype they are mistaken for
+var confusables = map[rune]rune{
+	'0': 'o', '1': 'l', '|': 'l', 'ı': 'i', 'ɡ': 'g',
+	// Cyrillic
+	'а': 'a', 'в': 'b', 'е': 'e', 'һ': 'h', 'і': 'i', 'ј': 'j', 'к': 'k', 'м': 'm', 'н': 'h', 'о': 'o',
+	'р': 'p', 'с': 'c', 'т': 't', 'у': 'y', 'х': 'x', 'ѕ': 's', 'ԁ': 'd', 'ԛ': 'q', 'ԝ': 'w', 'ӏ': 'l',
+	// Greek
+	'α': 'a', 'β': 'b', 'ε': 'e', 'η': 'n', 'ι': 'i', 'κ': 'k', 'ν': 'v', 'ο': 'o', 'ρ': 'p', 'τ': 't',
+	'υ': 'u', 'χ': 'x',
+}
+
+// confusableSequences are letter pairs that read as a single letter
+var confusableSequences = strings.NewReplacer("rn", "m", "vv", "w")
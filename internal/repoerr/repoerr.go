@@ -0,0 +1,121 @@
+package repoerr
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Kinds of domain errors, match them with errors.Is
+var (
+	ErrNotFound         = errors.New("not found")
+	ErrInvalidID        = errors.New("invalid id")
+	ErrConflict         = errors.New("conflict")
+	ErrValidationFailed = errors.New("validation failed")
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrUnauthenticated  = errors.New("unauthenticated")
+	ErrUnavailable      = errors.New("unavailable")
+	ErrReadOnly         = errors.New("read only")
+)
+
+// ErrVersionMismatch accompanies ErrConflict when a compare-and-swap update lost
+// against a concurrent writer
+var ErrVersionMismatch = errors.New("version mismatch")
+
+type Violation struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type Error struct {
+	Kind       error       // One of the Err* kinds
+	Message    string      // Safe to show to API callers
+	Violations []Violation // Per-field details for ErrValidationFailed
+	Err        error       // Underlying cause, never shown to API callers
+}
+
+func (e *Error) Error() string {
+	message := e.Message
+	if message == "" {
+		message = e.Kind.Error()
+	}
+
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", message, e.Err)
+	}
+
+	return message
+}
+
+func (e *Error) Unwrap() []error {
+	if e.Err == nil {
+		return []error{e.Kind}
+	}
+
+	return []error{e.Kind, e.Err}
+}
+
+func New(kind error, format string, args ...interface{}) *Error {
+	return &Error{Kind: kind, Message: fmt.Sprintf(format, args...)}
+}
+
+func Wrap(kind error, err error, format string, args ...interface{}) *Error {
+	return &Error{Kind: kind, Message: fmt.Sprintf(format, args...), Err: err}
+}
+
+func NotFound(format string, args ...interface{}) *Error {
+	return New(ErrNotFound, format, args...)
+}
+
+func Conflict(format string, args ...interface{}) *Error {
+	return New(ErrConflict, format, args...)
+}
+
+func VersionMismatch() *Error {
+	return Wrap(ErrConflict, ErrVersionMismatch, "repo was modified concurrently, reload it and retry")
+}
+
+func PermissionDenied(format string, args ...interface{}) *Error {
+	return New(ErrPermissionDenied, format, args...)
+}
+
+func Unauthenticated(format string, args ...interface{}) *Error {
+	return New(ErrUnauthenticated, format, args...)
+}
+
+func ReadOnly(format string, args ...interface{}) *Error {
+	return New(ErrReadOnly, format, args...)
+}
+
+func Validation(violations ...Violation) *Error {
+	fields := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		fields = append(fields, violation.Field)
+	}
+
+	return &Error{
+		Kind:       ErrValidationFailed,
+		Message:    "invalid " + strings.Join(fields, ", "),
+		Violations: violations,
+	}
+}
+
+// PublicMessage returns the message of a domain error, or fallback for anything else
+func PublicMessage(err error, fallback string) string {
+	var domainErr *Error
+	if errors.As(err, &domainErr) && domainErr.Message != "" {
+		return domainErr.Message
+	}
+
+	return fallback
+}
+
+// ViolationsOf returns the per-field violations carried by err, if any
+func ViolationsOf(err error) []Violation {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr.Violations
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package repoerr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError_Is(t *testing.T) {
+	cause := errors.New("cause")
+	err := fmt.Errorf("outer: %w", repoerr.Wrap(repoerr.ErrUnavailable, cause, "database unavailable"))
+
+	assert.True(t, errors.Is(err, repoerr.ErrUnavailable))
+	assert.True(t, errors.Is(err, cause))
+	assert.False(t, errors.Is(err, repoerr.ErrNotFound))
+}
+
+func TestError_Message(t *testing.T) {
+	assert.Equal(t, "repo not found", repoerr.NotFound("repo not found").Error())
+	assert.Equal(t, "not found", repoerr.New(repoerr.ErrNotFound, "").Error())
+	assert.Equal(t, "database unavailable: cause", repoerr.Wrap(repoerr.ErrUnavailable, errors.New("cause"), "database unavailable").Error())
+}
+
+func TestPublicMessage(t *testing.T) {
+	assert.Equal(t, "repo not found", repoerr.PublicMessage(repoerr.NotFound("repo not found"), "internal error"))
+	assert.Equal(t, "internal error", repoerr.PublicMessage(errors.New("secret details"), "internal error"))
+}
+
+func TestValidation(t *testing.T) {
+	err := repoerr.Validation(
+		repoerr.Violation{Field: "name", Message: "is required"},
+		repoerr.Violation{Field: "description", Message: "is too long"},
+	)
+
+	assert.True(t, errors.Is(err, repoerr.ErrValidationFailed))
+	assert.Equal(t, "invalid name, description", err.Error())
+	assert.Len(t, repoerr.ViolationsOf(fmt.Errorf("wrapped: %w", err)), 2)
+}
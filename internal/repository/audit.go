@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AuditRepository is an append-only log of changes to repos
+type AuditRepository interface {
+	Record(ctx context.Context, entry *model.AuditEntry) error
+	ListByRepo(ctx context.Context, repoID string) ([]*model.AuditEntry, error)
+}
+
+type MongoAuditRepository struct {
+	Collection MongoCollection
+}
+
+func NewAuditRepository(collection MongoCollection) *MongoAuditRepository {
+	return &MongoAuditRepository{
+		Collection: collection,
+	}
+}
+
+func (m *MongoAuditRepository) Record(ctx context.Context, entry *model.AuditEntry) error {
+	if entry.ID.IsZero() {
+		entry.ID = primitive.NewObjectID()
+	}
+
+	_, err := m.Collection.InsertOne(ctx, entry)
+	return mapMongoError(err, "audit entry")
+}
+
+// ListByRepo returns the entries of a repo, oldest first
+func (m *MongoAuditRepository) ListByRepo(ctx context.Context, repoID string) ([]*model.AuditEntry, error) {
+	objectID, err := primitive.ObjectIDFromHex(repoID)
+	if err != nil {
+		return nil, repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", repoID)
+	}
+
+	cursor, err := m.Collection.Find(ctx, bson.M{"repo_id": objectID}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, mapMongoError(err, "audit entry")
+	}
+
+	entries := []*model.AuditEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, mapMongoError(err, "audit entry")
+	}
+
+	return entries, nil
+}
+
+// MemoryAuditRepository keeps audit entries in process memory
+type MemoryAuditRepository struct {
+	mu      sync.RWMutex
+	entries []*model.AuditEntry
+}
+
+func NewMemoryAuditRepository() *MemoryAuditRepository {
+	return &MemoryAuditRepository{}
+}
+
+func (m *MemoryAuditRepository) Record(ctx context.Context, entry *model.AuditEntry) error {
+	if entry.ID.IsZero() {
+		entry.ID = primitive.NewObjectID()
+	}
+
+	copied := *entry
+	m.mu.Lock()
+	m.entries = append(m.entries, &copied)
+	m.mu.Unlock()
+
+	return nil
+}
+
+func (m *MemoryAuditRepository) ListByRepo(ctx context.Context, repoID string) ([]*model.AuditEntry, error) {
+	objectID, err := primitive.ObjectIDFromHex(repoID)
+	if err != nil {
+		return nil, repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", repoID)
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entries := []*model.AuditEntry{}
+	for _, entry := range m.entries {
+		if entry.RepoID == objectID {
+			copied := *entry
+			entries = append(entries, &copied)
+		}
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,278 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CollaboratorRepository stores who besides the owner holds a role on a repo. A user
+// collaborates at most once per repo.
+type CollaboratorRepository interface {
+	Add(ctx context.Context, collaborator *model.Collaborator) (*model.Collaborator, error)
+	Find(ctx context.Context, repoID primitive.ObjectID, userID string) (*model.Collaborator, error)
+	ListByRepo(ctx context.Context, repoID primitive.ObjectID) ([]*model.Collaborator, error)
+	ListRepoIDsByUser(ctx context.Context, userID string) ([]primitive.ObjectID, error)
+	UpdateRole(ctx context.Context, repoID primitive.ObjectID, userID string, role string, updatedAt time.Time) (*model.Collaborator, error)
+	Remove(ctx context.Context, repoID primitive.ObjectID, userID string) error
+	RemoveByRepo(ctx context.Context, repoID primitive.ObjectID) error
+}
+
+type MongoCollaboratorRepository struct {
+	Collection MongoCollection
+}
+
+func NewCollaboratorRepository(collection MongoCollection) *MongoCollaboratorRepository {
+	return &MongoCollaboratorRepository{
+		Collection: collection,
+	}
+}
+
+// EnsureIndexes creates the unique (repo_id, user_id) index and the user_id index
+// used to find a user's repos
+func (m *MongoCollaboratorRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := m.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "repo_id", Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("repo_id_user_id_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("user_id"),
+		},
+	})
+	return mapMongoError(err, "collaborator")
+}
+
+func (m *MongoCollaboratorRepository) Add(ctx context.Context, collaborator *model.Collaborator) (*model.Collaborator, error) {
+	if collaborator.ID.IsZero() {
+		collaborator.ID = primitive.NewObjectID()
+	}
+
+	if _, err := m.Collection.InsertOne(ctx, collaborator); err != nil {
+		return nil, mapCollaboratorError(err)
+	}
+
+	return collaborator, nil
+}
+
+func (m *MongoCollaboratorRepository) Find(ctx context.Context, repoID primitive.ObjectID, userID string) (*model.Collaborator, error) {
+	collaborator := &model.Collaborator{}
+	err := m.Collection.FindOne(ctx, bson.M{"repo_id": repoID, "user_id": userID}).Decode(collaborator)
+	if err != nil {
+		return nil, mapCollaboratorError(err)
+	}
+
+	return collaborator, nil
+}
+
+// ListByRepo returns the collaborators of a repo, oldest first
+func (m *MongoCollaboratorRepository) ListByRepo(ctx context.Context, repoID primitive.ObjectID) ([]*model.Collaborator, error) {
+	cursor, err := m.Collection.Find(ctx, bson.M{"repo_id": repoID}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, mapMongoError(err, "collaborator")
+	}
+
+	collaborators := []*model.Collaborator{}
+	if err := cursor.All(ctx, &collaborators); err != nil {
+		return nil, mapMongoError(err, "collaborator")
+	}
+
+	return collaborators, nil
+}
+
+func (m *MongoCollaboratorRepository) ListRepoIDsByUser(ctx context.Context, userID string) ([]primitive.ObjectID, error) {
+	cursor, err := m.Collection.Find(ctx, bson.M{"user_id": userID}, options.Find().SetProjection(bson.M{"repo_id": 1}))
+	if err != nil {
+		return nil, mapMongoError(err, "collaborator")
+	}
+
+	var documents []struct {
+		RepoID primitive.ObjectID `bson:"repo_id"`
+	}
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, mapMongoError(err, "collaborator")
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(documents))
+	for _, document := range documents {
+		ids = append(ids, document.RepoID)
+	}
+
+	return ids, nil
+}
+
+func (m *MongoCollaboratorRepository) UpdateRole(ctx context.Context, repoID primitive.ObjectID, userID string, role string, updatedAt time.Time) (*model.Collaborator, error) {
+	collaborator := &model.Collaborator{}
+	err := m.Collection.FindOneAndUpdate(ctx,
+		bson.M{"repo_id": repoID, "user_id": userID},
+		bson.M{"$set": bson.M{"role": role, "updated_at": updatedAt}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(collaborator)
+	if err != nil {
+		return nil, mapCollaboratorError(err)
+	}
+
+	return collaborator, nil
+}
+
+func (m *MongoCollaboratorRepository) Remove(ctx context.Context, repoID primitive.ObjectID, userID string) error {
+	result, err := m.Collection.DeleteOne(ctx, bson.M{"repo_id": repoID, "user_id": userID})
+	if err != nil {
+		return mapMongoError(err, "collaborator")
+	}
+	if result.DeletedCount == 0 {
+		return repoerr.NotFound("collaborator not found")
+	}
+
+	return nil
+}
+
+func (m *MongoCollaboratorRepository) RemoveByRepo(ctx context.Context, repoID primitive.ObjectID) error {
+	_, err := m.Collection.DeleteMany(ctx, bson.M{"repo_id": repoID})
+	return mapMongoError(err, "collaborator")
+}
+
+// mapCollaboratorError is mapMongoError with messages about collaborators
+func mapCollaboratorError(err error) error {
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return repoerr.Wrap(repoerr.ErrNotFound, err, "collaborator not found")
+	case mongo.IsDuplicateKeyError(err):
+		return repoerr.Wrap(repoerr.ErrConflict, err, "user is already a collaborator")
+	default:
+		return mapMongoError(err, "collaborator")
+	}
+}
+
+// MemoryCollaboratorRepository keeps collaborators in process memory
+type MemoryCollaboratorRepository struct {
+	mu            sync.RWMutex
+	collaborators map[collaboratorKey]*model.Collaborator
+}
+
+type collaboratorKey struct {
+	repoID primitive.ObjectID
+	userID string
+}
+
+func NewMemoryCollaboratorRepository() *MemoryCollaboratorRepository {
+	return &MemoryCollaboratorRepository{
+		collaborators: map[collaboratorKey]*model.Collaborator{},
+	}
+}
+
+func (m *MemoryCollaboratorRepository) Add(ctx context.Context, collaborator *model.Collaborator) (*model.Collaborator, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := collaboratorKey{collaborator.RepoID, collaborator.UserID}
+	if _, ok := m.collaborators[key]; ok {
+		return nil, repoerr.Conflict("user is already a collaborator")
+	}
+
+	if collaborator.ID.IsZero() {
+		collaborator.ID = primitive.NewObjectID()
+	}
+	copied := *collaborator
+	m.collaborators[key] = &copied
+
+	return collaborator, nil
+}
+
+func (m *MemoryCollaboratorRepository) Find(ctx context.Context, repoID primitive.ObjectID, userID string) (*model.Collaborator, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	collaborator, ok := m.collaborators[collaboratorKey{repoID, userID}]
+	if !ok {
+		return nil, repoerr.NotFound("collaborator not found")
+	}
+
+	copied := *collaborator
+	return &copied, nil
+}
+
+func (m *MemoryCollaboratorRepository) ListByRepo(ctx context.Context, repoID primitive.ObjectID) ([]*model.Collaborator, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	collaborators := []*model.Collaborator{}
+	for key, collaborator := range m.collaborators {
+		if key.repoID == repoID {
+			copied := *collaborator
+			collaborators = append(collaborators, &copied)
+		}
+	}
+
+	sort.Slice(collaborators, func(i, j int) bool {
+		return collaborators[i].ID.Hex() < collaborators[j].ID.Hex()
+	})
+
+	return collaborators, nil
+}
+
+func (m *MemoryCollaboratorRepository) ListRepoIDsByUser(ctx context.Context, userID string) ([]primitive.ObjectID, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids := []primitive.ObjectID{}
+	for key := range m.collaborators {
+		if key.userID == userID {
+			ids = append(ids, key.repoID)
+		}
+	}
+
+	return ids, nil
+}
+
+func (m *MemoryCollaboratorRepository) UpdateRole(ctx context.Context, repoID primitive.ObjectID, userID string, role string, updatedAt time.Time) (*model.Collaborator, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	collaborator, ok := m.collaborators[collaboratorKey{repoID, userID}]
+	if !ok {
+		return nil, repoerr.NotFound("collaborator not found")
+	}
+
+	collaborator.Role = role
+	collaborator.UpdatedAt = updatedAt
+
+	copied := *collaborator
+	return &copied, nil
+}
+
+func (m *MemoryCollaboratorRepository) Remove(ctx context.Context, repoID primitive.ObjectID, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := collaboratorKey{repoID, userID}
+	if _, ok := m.collaborators[key]; !ok {
+		return repoerr.NotFound("collaborator not found")
+	}
+	delete(m.collaborators, key)
+
+	return nil
+}
+
+func (m *MemoryCollaboratorRepository) RemoveByRepo(ctx context.Context, repoID primitive.ObjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key := range m.collaborators {
+		if key.repoID == repoID {
+			delete(m.collaborators, key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository/repositorytest"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// conformanceSuite runs the suite of one store. collection hands out a fresh Mongo
+// collection per test, it is nil for the in-memory backend.
+type conformanceSuite struct {
+	name string
+	run  func(t *testing.T, collection func(t *testing.T) *mongo.Collection)
+}
+
+// suite adapts a typed conformance suite and the constructors of both backends
+func suite[S any, F ~func(*testing.T) S](name string, run func(*testing.T, F), newMemory func() S, newMongo func(repository.MongoCollection) S) conformanceSuite {
+	return conformanceSuite{
+		name: name,
+		run: func(t *testing.T, collection func(t *testing.T) *mongo.Collection) {
+			run(t, F(func(t *testing.T) S {
+				if collection == nil {
+					return newMemory()
+				}
+
+				store := newMongo(collection(t))
+				indexed, ok := any(store).(interface{ EnsureIndexes(context.Context) error })
+				require.True(t, ok, "%T has no EnsureIndexes", store)
+				require.NoError(t, indexed.EnsureIndexes(context.Background()))
+
+				return store
+			}))
+		},
+	}
+}
+
+var conformanceSuites = []conformanceSuite{
+	suite("Repo", repositorytest.RunConformance,
+		func() repository.RepoRepository { return repository.NewMemoryRepoRepository() },
+		func(c repository.MongoCollection) repository.RepoRepository {
+			return repository.NewRepoRepository(c)
+		}),
+	suite("Collaborator", repositorytest.RunCollaboratorConformance,
+		func() repository.CollaboratorRepository { return repository.NewMemoryCollaboratorRepository() },
+		func(c repository.MongoCollection) repository.CollaboratorRepository {
+			return repository.NewCollaboratorRepository(c)
+		}),
+	suite("Organization", repositorytest.RunOrganizationConformance,
+		func() repository.OrganizationRepository { return repository.NewMemoryOrganizationRepository() },
+		func(c repository.MongoCollection) repository.OrganizationRepository {
+			return repository.NewOrganizationRepository(c)
+		}),
+	suite("Team", repositorytest.RunTeamConformance,
+		func() repository.TeamRepository { return repository.NewMemoryTeamRepository() },
+		func(c repository.MongoCollection) repository.TeamRepository {
+			return repository.NewTeamRepository(c)
+		}),
+	suite("Redirect", repositorytest.RunRedirectConformance,
+		func() repository.RedirectRepository { return repository.NewMemoryRedirectRepository() },
+		func(c repository.MongoCollection) repository.RedirectRepository {
+			return repository.NewRedirectRepository(c)
+		}),
+	suite("Subscription", repositorytest.RunSubscriptionConformance,
+		func() repository.SubscriptionRepository { return repository.NewMemorySubscriptionRepository() },
+		func(c repository.MongoCollection) repository.SubscriptionRepository {
+			return repository.NewSubscriptionRepository(c)
+		}),
+}
+
+// Runs every suite in memory, and against a real server when REPO_TEST_MONGO_URI is set
+func TestConformance(t *testing.T) {
+	var client *mongo.Client
+	if uri := os.Getenv("REPO_TEST_MONGO_URI"); uri != "" {
+		var err error
+		client, err = mongo.Connect(context.Background(), options.Client().ApplyURI(uri))
+		require.NoError(t, err)
+		t.Cleanup(func() { client.Disconnect(context.Background()) })
+	}
+
+	for _, s := range conformanceSuites {
+		t.Run(s.name, func(t *testing.T) {
+			t.Run("Memory", func(t *testing.T) {
+				s.run(t, nil)
+			})
+
+			t.Run("Mongo", func(t *testing.T) {
+				if client == nil {
+					t.Skip("REPO_TEST_MONGO_URI not set")
+				}
+
+				s.run(t, func(t *testing.T) *mongo.Collection {
+					collection := client.Database("repo_service_test").Collection(strings.ToLower(s.name) + "s_" + primitive.NewObjectID().Hex())
+					t.Cleanup(func() { collection.Drop(context.Background()) })
+
+					return collection
+				})
+			})
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// mapMongoError translates driver errors into domain errors so callers never need
+// to import the Mongo driver. entity names what the store holds, e.g. "repo".
+func mapMongoError(err error, entity string) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return repoerr.Wrap(repoerr.ErrNotFound, err, "%s not found", entity)
+	case mongo.IsDuplicateKeyError(err):
+		return repoerr.Wrap(repoerr.ErrConflict, err, "%s already exists", entity)
+	case mongo.IsTimeout(err), mongo.IsNetworkError(err):
+		return repoerr.Wrap(repoerr.ErrUnavailable, err, "repository unavailable")
+	default:
+		return err
+	}
+}
@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// listCursor is the position after the last repo of a page. Pages are keyset based
+// on (sort key, _id), so repos inserted between requests never shift later pages.
+type listCursor struct {
+	SortBy     string `json:"s"`
+	Descending bool   `json:"d"`
+	Value      string `json:"v"`
+	ID         string `json:"id"`
+}
+
+type cursorPosition struct {
+	value interface{}
+	id    primitive.ObjectID
+}
+
+func sortKey(query *model.RepoListQuery) string {
+	if query.SortBy == "" {
+		return model.SortByCreatedAt
+	}
+
+	return query.SortBy
+}
+
+func sortValue(repo *model.PrivateRepoModel, key string) interface{} {
+	switch key {
+	case model.SortByName:
+		return repo.Name
+	case model.SortByUpdatedAt:
+		return repo.UpdatedAt
+	case model.SortByStars:
+		return repo.StarsCount
+	case model.SortByWatchers:
+		return repo.WatchersCount
+	default:
+		return repo.CreatedAt
+	}
+}
+
+func encodeCursor(query *model.RepoListQuery, last *model.PrivateRepoModel) string {
+	key := sortKey(query)
+
+	cursor := listCursor{SortBy: key, Descending: query.Descending, ID: last.ID.Hex()}
+	switch value := sortValue(last, key).(type) {
+	case time.Time:
+		cursor.Value = value.UTC().Format(time.RFC3339Nano)
+	case string:
+		cursor.Value = value
+	case int64:
+		cursor.Value = strconv.FormatInt(value, 10)
+	}
+
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(query *model.RepoListQuery) (*cursorPosition, error) {
+	if query.Cursor == "" {
+		return nil, nil
+	}
+
+	invalid := repoerr.Validation(repoerr.Violation{Field: "cursor", Message: "is invalid"})
+
+	data, err := base64.RawURLEncoding.DecodeString(query.Cursor)
+	if err != nil {
+		return nil, invalid
+	}
+
+	cursor := listCursor{}
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, invalid
+	}
+
+	if cursor.SortBy != sortKey(query) || cursor.Descending != query.Descending {
+		return nil, repoerr.Validation(repoerr.Violation{Field: "cursor", Message: "does not match the requested sort order"})
+	}
+
+	id, err := primitive.ObjectIDFromHex(cursor.ID)
+	if err != nil {
+		return nil, invalid
+	}
+
+	position := &cursorPosition{id: id, value: cursor.Value}
+	switch cursor.SortBy {
+	case model.SortByName:
+	case model.SortByStars, model.SortByWatchers:
+		count, err := strconv.ParseInt(cursor.Value, 10, 64)
+		if err != nil {
+			return nil, invalid
+		}
+		position.value = count
+	default:
+		at, err := time.Parse(time.RFC3339Nano, cursor.Value)
+		if err != nil {
+			return nil, invalid
+		}
+		position.value = at
+	}
+
+	return position, nil
+}
+
+// compareSortValues orders two values of the same sort key
+func compareSortValues(a, b interface{}) int {
+	switch av := a.(type) {
+	case string:
+		return strings.Compare(av, b.(string))
+	case time.Time:
+		bv := b.(time.Time)
+		switch {
+		case av.Before(bv):
+			return -1
+		case av.After(bv):
+			return 1
+		}
+	case int64:
+		bv := b.(int64)
+		switch {
+		case av < bv:
+			return -1
+		case av > bv:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// comparePosition orders a repo against a keyset position, ties broken by _id
+func comparePosition(repo *model.PrivateRepoModel, key string, position *cursorPosition) int {
+	if c := compareSortValues(sortValue(repo, key), position.value); c != 0 {
+		return c
+	}
+
+	return bytes.Compare(repo.ID[:], position.id[:])
+}
+
+func matchesListQuery(repo *model.PrivateRepoModel, query *model.RepoListQuery) bool {
+	if query.OwnerID != "" && repo.OwnerID != query.OwnerID {
+		return false
+	}
+	if query.Name != "" && repo.Name != query.Name {
+		return false
+	}
+	if query.NamePrefix != "" && !strings.HasPrefix(repo.Name, query.NamePrefix) {
+		return false
+	}
+	if !inRange(repo.CreatedAt, query.CreatedAfter, query.CreatedBefore) {
+		return false
+	}
+	if !query.ParentID.IsZero() && (repo.ParentID == nil || *repo.ParentID != query.ParentID) {
+		return false
+	}
+	if !query.NetworkID.IsZero() && repo.NetworkID() != query.NetworkID {
+		return false
+	}
+	if query.Archived != nil && repo.Archived() != *query.Archived {
+		return false
+	}
+	if len(query.IDs) > 0 && !containsID(query.IDs, repo.ID) {
+		return false
+	}
+	if query.Access != nil && !query.Access.Allows(repo) {
+		return false
+	}
+
+	return inRange(repo.UpdatedAt, query.UpdatedAfter, query.UpdatedBefore)
+}
+
+func inRange(at, after, before time.Time) bool {
+	if !after.IsZero() && at.Before(after) {
+		return false
+	}
+
+	return before.IsZero() || at.Before(before)
+}
+
+func containsID(ids []primitive.ObjectID, id primitive.ObjectID) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+
+	return false
+}
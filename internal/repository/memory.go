@@ -0,0 +1,412 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MemoryRepoRepository keeps repos in process memory. It mirrors the behaviour of
+// MongoRepoRepository and is meant for local development and tests.
+type MemoryRepoRepository struct {
+	mu    sync.RWMutex
+	repos map[primitive.ObjectID]*model.PrivateRepoModel
+	order []primitive.ObjectID // Insertion order, so name lookups are deterministic
+}
+
+func NewMemoryRepoRepository() *MemoryRepoRepository {
+	return &MemoryRepoRepository{
+		repos: make(map[primitive.ObjectID]*model.PrivateRepoModel),
+	}
+}
+
+func (m *MemoryRepoRepository) FindById(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	repo, ok := m.repos[objectID]
+	if !ok || repo.Trashed() {
+		return nil, repoerr.NotFound("repo not found")
+	}
+
+	return clone(repo), nil
+}
+
+func (m *MemoryRepoRepository) FindByName(ctx context.Context, name string) (*model.PrivateRepoModel, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, id := range m.order {
+		if repo := m.repos[id]; repo.Name == name && !repo.Trashed() {
+			return clone(repo), nil
+		}
+	}
+
+	return nil, repoerr.NotFound("repo not found")
+}
+
+func (m *MemoryRepoRepository) FindByOwnerAndName(ctx context.Context, ownerID string, name string) (*model.PrivateRepoModel, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if repo := m.findByOwnerAndName(ownerID, name); repo != nil && !repo.Trashed() {
+		return clone(repo), nil
+	}
+
+	return nil, repoerr.NotFound("repo not found")
+}
+
+// FindByOwnerAndSkeleton also finds repos in the trash, whose names stay reserved
+// until they are purged
+func (m *MemoryRepoRepository) FindByOwnerAndSkeleton(ctx context.Context, ownerID string, skeleton string) (*model.PrivateRepoModel, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, id := range m.order {
+		if repo := m.repos[id]; repo.OwnerID == ownerID && repo.Skeleton != "" && repo.Skeleton == skeleton {
+			return clone(repo), nil
+		}
+	}
+
+	return nil, repoerr.NotFound("repo not found")
+}
+
+func (m *MemoryRepoRepository) Create(ctx context.Context, repo *model.PrivateRepoModel) (*model.PrivateRepoModel, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if repo.ID.IsZero() {
+		repo.ID = primitive.NewObjectID()
+	}
+
+	if _, exists := m.repos[repo.ID]; exists {
+		return nil, repoerr.Conflict("repo already exists")
+	}
+
+	if m.findByOwnerAndName(repo.OwnerID, repo.Name) != nil {
+		return nil, repoerr.Conflict("repo already exists")
+	}
+
+	m.repos[repo.ID] = clone(repo)
+	m.order = append(m.order, repo.ID)
+
+	return repo, nil
+}
+
+func (m *MemoryRepoRepository) UpdateOne(ctx context.Context, repo *model.PrivateRepoModel) (*model.PrivateRepoModel, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, exists := m.repos[repo.ID]
+	if !exists || stored.Trashed() {
+		return nil, repoerr.NotFound("repo not found")
+	}
+
+	if stored.Version != repo.Version {
+		return nil, repoerr.VersionMismatch()
+	}
+
+	if existing := m.findByOwnerAndName(repo.OwnerID, repo.Name); existing != nil && existing.ID != repo.ID {
+		return nil, repoerr.Conflict("repo already exists")
+	}
+
+	updated := clone(repo)
+	updated.Version++
+	updated.ForksCount = stored.ForksCount
+	updated.StarsCount = stored.StarsCount
+	updated.WatchersCount = stored.WatchersCount
+	m.repos[repo.ID] = updated
+
+	return clone(updated), nil
+}
+
+func (m *MemoryRepoRepository) PatchOne(ctx context.Context, id string, patch *model.RepoPatch) (*model.PrivateRepoModel, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, exists := m.repos[objectID]
+	if !exists || stored.Trashed() {
+		return nil, repoerr.NotFound("repo not found")
+	}
+
+	if patch.Version != 0 && stored.Version != patch.Version {
+		return nil, repoerr.VersionMismatch()
+	}
+
+	updated := clone(stored)
+	patch.Apply(updated)
+
+	if existing := m.findByOwnerAndName(updated.OwnerID, updated.Name); existing != nil && existing.ID != updated.ID {
+		return nil, repoerr.Conflict("repo already exists")
+	}
+
+	updated.Version++
+	m.repos[objectID] = updated
+
+	return clone(updated), nil
+}
+
+// DeleteOne removes a repo for good, whether it is in the trash or not
+func (m *MemoryRepoRepository) DeleteOne(ctx context.Context, repo *model.PrivateRepoModel) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, exists := m.repos[repo.ID]; !exists {
+		return repoerr.NotFound("repo not found")
+	}
+
+	delete(m.repos, repo.ID)
+	for i, id := range m.order {
+		if id == repo.ID {
+			m.order = append(m.order[:i], m.order[i+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (m *MemoryRepoRepository) List(ctx context.Context, query *model.RepoListQuery) (*model.RepoPage, error) {
+	position, err := decodeCursor(query)
+	if err != nil {
+		return nil, err
+	}
+
+	key := sortKey(query)
+	direction := 1
+	if query.Descending {
+		direction = -1
+	}
+
+	m.mu.RLock()
+	repos := []*model.PrivateRepoModel{}
+	for _, id := range m.order {
+		repo := m.repos[id]
+		if repo.Trashed() || !matchesListQuery(repo, query) {
+			continue
+		}
+		if position != nil && comparePosition(repo, key, position)*direction <= 0 {
+			continue
+		}
+
+		repos = append(repos, clone(repo))
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(repos, func(i, j int) bool {
+		position := &cursorPosition{value: sortValue(repos[j], key), id: repos[j].ID}
+		return comparePosition(repos[i], key, position)*direction < 0
+	})
+
+	if query.Limit > 0 && len(repos) > query.Limit+1 {
+		repos = repos[:query.Limit+1]
+	}
+
+	return newPage(query, repos), nil
+}
+
+func (m *MemoryRepoRepository) Count(ctx context.Context, query *model.RepoListQuery) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	count := int64(0)
+	for _, repo := range m.repos {
+		if !repo.Trashed() && matchesListQuery(repo, query) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (m *MemoryRepoRepository) Trash(ctx context.Context, id string, deletedAt time.Time) (*model.PrivateRepoModel, error) {
+	return m.setDeletedAt(id, false, &deletedAt)
+}
+
+func (m *MemoryRepoRepository) Restore(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	return m.setDeletedAt(id, true, nil)
+}
+
+// setDeletedAt moves a repo into or out of the trash, a repo not in the expected
+// state is reported missing
+func (m *MemoryRepoRepository) setDeletedAt(id string, inTrash bool, deletedAt *time.Time) (*model.PrivateRepoModel, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, exists := m.repos[objectID]
+	if !exists || stored.Trashed() != inTrash {
+		return nil, repoerr.NotFound("repo not found")
+	}
+
+	updated := clone(stored)
+	updated.DeletedAt = deletedAt
+	updated.Version++
+	m.repos[objectID] = clone(updated)
+
+	return updated, nil
+}
+
+func (m *MemoryRepoRepository) FindTrashedById(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	repo, ok := m.repos[objectID]
+	if !ok || !repo.Trashed() {
+		return nil, repoerr.NotFound("repo not found")
+	}
+
+	return clone(repo), nil
+}
+
+func (m *MemoryRepoRepository) ListTrashed(ctx context.Context, deletedBefore time.Time) ([]*model.PrivateRepoModel, error) {
+	m.mu.RLock()
+	repos := []*model.PrivateRepoModel{}
+	for _, id := range m.order {
+		if repo := m.repos[id]; repo.Trashed() && repo.DeletedAt.Before(deletedBefore) {
+			repos = append(repos, clone(repo))
+		}
+	}
+	m.mu.RUnlock()
+
+	sort.SliceStable(repos, func(i, j int) bool {
+		return repos[i].DeletedAt.Before(*repos[j].DeletedAt)
+	})
+
+	return repos, nil
+}
+
+func (m *MemoryRepoRepository) AddForks(ctx context.Context, id string, delta int) error {
+	return m.updateCount(id, func(repo *model.PrivateRepoModel) { repo.ForksCount += int64(delta) })
+}
+
+func (m *MemoryRepoRepository) SetForks(ctx context.Context, id string, count int64) error {
+	return m.updateCount(id, func(repo *model.PrivateRepoModel) { repo.ForksCount = count })
+}
+
+func (m *MemoryRepoRepository) AddStars(ctx context.Context, id string, delta int) error {
+	return m.updateCount(id, func(repo *model.PrivateRepoModel) { repo.StarsCount += int64(delta) })
+}
+
+func (m *MemoryRepoRepository) SetStars(ctx context.Context, id string, count int64) error {
+	return m.updateCount(id, func(repo *model.PrivateRepoModel) { repo.StarsCount = count })
+}
+
+func (m *MemoryRepoRepository) AddWatchers(ctx context.Context, id string, delta int) error {
+	return m.updateCount(id, func(repo *model.PrivateRepoModel) { repo.WatchersCount += int64(delta) })
+}
+
+func (m *MemoryRepoRepository) SetWatchers(ctx context.Context, id string, count int64) error {
+	return m.updateCount(id, func(repo *model.PrivateRepoModel) { repo.WatchersCount = count })
+}
+
+func (m *MemoryRepoRepository) updateCount(id string, update func(repo *model.PrivateRepoModel)) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, exists := m.repos[objectID]
+	if !exists {
+		return repoerr.NotFound("repo not found")
+	}
+
+	update(stored)
+	return nil
+}
+
+func (m *MemoryRepoRepository) SetForkLinks(ctx context.Context, id string, parentID *primitive.ObjectID, rootID *primitive.ObjectID) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, exists := m.repos[objectID]
+	if !exists {
+		return repoerr.NotFound("repo not found")
+	}
+
+	updated := clone(stored)
+	updated.ParentID = cloneID(parentID)
+	updated.RootID = cloneID(rootID)
+	updated.Version++
+	m.repos[objectID] = updated
+
+	return nil
+}
+
+// findByOwnerAndName mirrors the unique (owner_id, name) index, which includes the
+// repos in the trash. Callers hold the lock.
+func (m *MemoryRepoRepository) findByOwnerAndName(ownerID string, name string) *model.PrivateRepoModel {
+	for _, id := range m.order {
+		if repo := m.repos[id]; repo.OwnerID == ownerID && repo.Name == name {
+			return repo
+		}
+	}
+
+	return nil
+}
+
+// clone copies a repo so callers can never mutate stored state
+func clone(repo *model.PrivateRepoModel) *model.PrivateRepoModel {
+	copied := *repo
+	copied.PreviousNames = append([]string(nil), repo.PreviousNames...)
+	if repo.DeletedAt != nil {
+		deletedAt := *repo.DeletedAt
+		copied.DeletedAt = &deletedAt
+	}
+	if repo.ArchivedAt != nil {
+		archivedAt := *repo.ArchivedAt
+		copied.ArchivedAt = &archivedAt
+	}
+	copied.ParentID = cloneID(repo.ParentID)
+	copied.RootID = cloneID(repo.RootID)
+	copied.TemplateID = cloneID(repo.TemplateID)
+	copied.Topics = append([]string(nil), repo.Topics...)
+	if repo.Properties != nil {
+		copied.Properties = make(map[string]string, len(repo.Properties))
+		for key, value := range repo.Properties {
+			copied.Properties[key] = value
+		}
+	}
+	return &copied
+}
+
+func cloneID(id *primitive.ObjectID) *primitive.ObjectID {
+	if id == nil {
+		return nil
+	}
+
+	copied := *id
+	return &copied
+}
@@ -0,0 +1,114 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestMemory_CreateAndFind(t *testing.T) {
+	ctx := context.TODO()
+	repository := repository.NewMemoryRepoRepository()
+
+	created, err := repository.Create(ctx, &model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: "test"})
+	assert.Nil(t, err)
+
+	byID, err := repository.FindById(ctx, created.ID.Hex())
+	assert.Nil(t, err)
+	assert.Equal(t, "test", byID.Name)
+
+	byName, err := repository.FindByName(ctx, "test")
+	assert.Nil(t, err)
+	assert.Equal(t, created.ID, byName.ID)
+}
+
+func TestMemory_Error_NotFound(t *testing.T) {
+	ctx := context.TODO()
+	repository := repository.NewMemoryRepoRepository()
+
+	_, err := repository.FindById(ctx, primitive.NewObjectID().Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = repository.FindByName(ctx, "missing")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = repository.FindById(ctx, "invalid")
+	assert.ErrorIs(t, err, repoerr.ErrInvalidID)
+}
+
+func TestMemory_ReturnsCopies(t *testing.T) {
+	ctx := context.TODO()
+	repository := repository.NewMemoryRepoRepository()
+
+	created, _ := repository.Create(ctx, &model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: "test"})
+	created.Name = "mutated"
+
+	found, _ := repository.FindById(ctx, created.ID.Hex())
+	found.Description = "mutated"
+
+	again, _ := repository.FindById(ctx, created.ID.Hex())
+	assert.Equal(t, "test", again.Name)
+	assert.Equal(t, "", again.Description)
+}
+
+func TestMemory_UpdateAndDelete(t *testing.T) {
+	ctx := context.TODO()
+	repository := repository.NewMemoryRepoRepository()
+
+	created, _ := repository.Create(ctx, &model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: "test"})
+
+	_, err := repository.UpdateOne(ctx, &model.PrivateRepoModel{ID: created.ID, Name: "renamed"})
+	assert.Nil(t, err)
+
+	_, err = repository.FindByName(ctx, "test")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	assert.Nil(t, repository.DeleteOne(ctx, created))
+	assert.ErrorIs(t, repository.DeleteOne(ctx, created), repoerr.ErrNotFound)
+
+	_, err = repository.UpdateOne(ctx, created)
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func TestMemory_Concurrent(t *testing.T) {
+	ctx := context.TODO()
+	repository := repository.NewMemoryRepoRepository()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			created, err := repository.Create(ctx, &model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: fmt.Sprintf("test-%d", i)})
+			assert.Nil(t, err)
+			_, err = repository.FindById(ctx, created.ID.Hex())
+			assert.Nil(t, err)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestMemoryAudit_RecordAndList(t *testing.T) {
+	ctx := context.TODO()
+	audit := repository.NewMemoryAuditRepository()
+	repoID := primitive.NewObjectID()
+
+	assert.Nil(t, audit.Record(ctx, &model.AuditEntry{RepoID: repoID, Action: model.AuditVisibilityChanged}))
+	assert.Nil(t, audit.Record(ctx, &model.AuditEntry{RepoID: primitive.NewObjectID(), Action: model.AuditVisibilityChanged}))
+
+	entries, err := audit.ListByRepo(ctx, repoID.Hex())
+
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+	assert.False(t, entries[0].ID.IsZero())
+
+	_, err = audit.ListByRepo(ctx, "invalid")
+	assert.ErrorIs(t, err, repoerr.ErrInvalidID)
+}
@@ -0,0 +1,329 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// OrganizationRepository stores organizations along with their members. Logins are
+// unique.
+type OrganizationRepository interface {
+	Create(ctx context.Context, org *model.Organization) (*model.Organization, error)
+	FindByID(ctx context.Context, id primitive.ObjectID) (*model.Organization, error)
+	FindByLogin(ctx context.Context, login string) (*model.Organization, error)
+	FindMember(ctx context.Context, orgID primitive.ObjectID, userID string) (*model.OrgMember, error)
+	ListIDsByMember(ctx context.Context, userID string) ([]primitive.ObjectID, error)
+	SetMember(ctx context.Context, orgID primitive.ObjectID, member model.OrgMember, updatedAt time.Time) (*model.Organization, error)
+	RemoveMember(ctx context.Context, orgID primitive.ObjectID, userID string, updatedAt time.Time) (*model.Organization, error)
+}
+
+type MongoOrganizationRepository struct {
+	Collection MongoCollection
+}
+
+func NewOrganizationRepository(collection MongoCollection) *MongoOrganizationRepository {
+	return &MongoOrganizationRepository{
+		Collection: collection,
+	}
+}
+
+// EnsureIndexes creates the unique login index and the members.user_id index used
+// to find a user's organizations
+func (m *MongoOrganizationRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := m.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "login", Value: 1}},
+			Options: options.Index().SetName("login_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "members.user_id", Value: 1}},
+			Options: options.Index().SetName("members_user_id"),
+		},
+	})
+	return mapMongoError(err, "organization")
+}
+
+func (m *MongoOrganizationRepository) Create(ctx context.Context, org *model.Organization) (*model.Organization, error) {
+	if org.ID.IsZero() {
+		org.ID = primitive.NewObjectID()
+	}
+	if org.Members == nil {
+		org.Members = []model.OrgMember{} // $push fails on a null array
+	}
+
+	if _, err := m.Collection.InsertOne(ctx, org); err != nil {
+		return nil, mapOrganizationError(err)
+	}
+
+	return org, nil
+}
+
+func (m *MongoOrganizationRepository) FindByID(ctx context.Context, id primitive.ObjectID) (*model.Organization, error) {
+	return m.findOne(ctx, bson.M{"_id": id})
+}
+
+func (m *MongoOrganizationRepository) FindByLogin(ctx context.Context, login string) (*model.Organization, error) {
+	return m.findOne(ctx, bson.M{"login": login})
+}
+
+func (m *MongoOrganizationRepository) findOne(ctx context.Context, filter bson.M) (*model.Organization, error) {
+	org := &model.Organization{}
+	if err := m.Collection.FindOne(ctx, filter).Decode(org); err != nil {
+		return nil, mapOrganizationError(err)
+	}
+
+	return org, nil
+}
+
+// FindMember returns a NotFound error when the user is not a member, or the
+// organization does not exist
+func (m *MongoOrganizationRepository) FindMember(ctx context.Context, orgID primitive.ObjectID, userID string) (*model.OrgMember, error) {
+	org, err := m.FindByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	member, ok := org.Member(userID)
+	if !ok {
+		return nil, repoerr.NotFound("member not found")
+	}
+
+	return &member, nil
+}
+
+func (m *MongoOrganizationRepository) ListIDsByMember(ctx context.Context, userID string) ([]primitive.ObjectID, error) {
+	cursor, err := m.Collection.Find(ctx, bson.M{"members.user_id": userID}, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, mapMongoError(err, "organization")
+	}
+
+	var documents []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, mapMongoError(err, "organization")
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(documents))
+	for _, document := range documents {
+		ids = append(ids, document.ID)
+	}
+
+	return ids, nil
+}
+
+// SetMember adds a member or changes the role of an existing one
+func (m *MongoOrganizationRepository) SetMember(ctx context.Context, orgID primitive.ObjectID, member model.OrgMember, updatedAt time.Time) (*model.Organization, error) {
+	after := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	// Changing the role and adding are two updates, the second attempt at changing the
+	// role catches a member added concurrently in between
+	for attempt := 0; attempt < 2; attempt++ {
+		org := &model.Organization{}
+		err := m.Collection.FindOneAndUpdate(ctx,
+			bson.M{"_id": orgID, "members.user_id": member.UserID},
+			bson.M{"$set": bson.M{"members.$.role": member.Role, "updated_at": updatedAt}},
+			after,
+		).Decode(org)
+		switch {
+		case err == nil:
+			return org, nil
+		case !errors.Is(err, mongo.ErrNoDocuments):
+			return nil, mapOrganizationError(err)
+		}
+
+		org = &model.Organization{}
+		err = m.Collection.FindOneAndUpdate(ctx,
+			bson.M{"_id": orgID, "members.user_id": bson.M{"$ne": member.UserID}},
+			bson.M{"$push": bson.M{"members": member}, "$set": bson.M{"updated_at": updatedAt}},
+			after,
+		).Decode(org)
+		switch {
+		case err == nil:
+			return org, nil
+		case !errors.Is(err, mongo.ErrNoDocuments):
+			return nil, mapOrganizationError(err)
+		}
+	}
+
+	return nil, repoerr.NotFound("organization not found")
+}
+
+func (m *MongoOrganizationRepository) RemoveMember(ctx context.Context, orgID primitive.ObjectID, userID string, updatedAt time.Time) (*model.Organization, error) {
+	org := &model.Organization{}
+	err := m.Collection.FindOneAndUpdate(ctx,
+		bson.M{"_id": orgID, "members.user_id": userID},
+		bson.M{"$pull": bson.M{"members": bson.M{"user_id": userID}}, "$set": bson.M{"updated_at": updatedAt}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(org)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		if _, err := m.FindByID(ctx, orgID); err != nil {
+			return nil, err
+		}
+
+		return nil, repoerr.NotFound("member not found")
+	}
+	if err != nil {
+		return nil, mapOrganizationError(err)
+	}
+
+	return org, nil
+}
+
+// mapOrganizationError is mapMongoError with messages about organizations
+func mapOrganizationError(err error) error {
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return repoerr.Wrap(repoerr.ErrNotFound, err, "organization not found")
+	case mongo.IsDuplicateKeyError(err):
+		return repoerr.Wrap(repoerr.ErrConflict, err, "organization login is taken")
+	default:
+		return mapMongoError(err, "organization")
+	}
+}
+
+// MemoryOrganizationRepository keeps organizations in process memory
+type MemoryOrganizationRepository struct {
+	mu   sync.RWMutex
+	orgs map[primitive.ObjectID]*model.Organization
+}
+
+func NewMemoryOrganizationRepository() *MemoryOrganizationRepository {
+	return &MemoryOrganizationRepository{
+		orgs: map[primitive.ObjectID]*model.Organization{},
+	}
+}
+
+func cloneOrganization(org *model.Organization) *model.Organization {
+	copied := *org
+	copied.Members = append([]model.OrgMember{}, org.Members...)
+	return &copied
+}
+
+func (m *MemoryOrganizationRepository) Create(ctx context.Context, org *model.Organization) (*model.Organization, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, existing := range m.orgs {
+		if existing.Login == org.Login {
+			return nil, repoerr.Conflict("organization login is taken")
+		}
+	}
+
+	if org.ID.IsZero() {
+		org.ID = primitive.NewObjectID()
+	}
+	m.orgs[org.ID] = cloneOrganization(org)
+
+	return org, nil
+}
+
+func (m *MemoryOrganizationRepository) FindByID(ctx context.Context, id primitive.ObjectID) (*model.Organization, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	org, ok := m.orgs[id]
+	if !ok {
+		return nil, repoerr.NotFound("organization not found")
+	}
+
+	return cloneOrganization(org), nil
+}
+
+func (m *MemoryOrganizationRepository) FindByLogin(ctx context.Context, login string) (*model.Organization, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, org := range m.orgs {
+		if org.Login == login {
+			return cloneOrganization(org), nil
+		}
+	}
+
+	return nil, repoerr.NotFound("organization not found")
+}
+
+func (m *MemoryOrganizationRepository) FindMember(ctx context.Context, orgID primitive.ObjectID, userID string) (*model.OrgMember, error) {
+	org, err := m.FindByID(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	member, ok := org.Member(userID)
+	if !ok {
+		return nil, repoerr.NotFound("member not found")
+	}
+
+	return &member, nil
+}
+
+func (m *MemoryOrganizationRepository) ListIDsByMember(ctx context.Context, userID string) ([]primitive.ObjectID, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ids := []primitive.ObjectID{}
+	for id, org := range m.orgs {
+		if _, ok := org.Member(userID); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, nil
+}
+
+func (m *MemoryOrganizationRepository) SetMember(ctx context.Context, orgID primitive.ObjectID, member model.OrgMember, updatedAt time.Time) (*model.Organization, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	org, ok := m.orgs[orgID]
+	if !ok {
+		return nil, repoerr.NotFound("organization not found")
+	}
+
+	found := false
+	for i := range org.Members {
+		if org.Members[i].UserID == member.UserID {
+			org.Members[i].Role = member.Role
+			found = true
+		}
+	}
+	if !found {
+		org.Members = append(org.Members, member)
+	}
+	org.UpdatedAt = updatedAt
+
+	return cloneOrganization(org), nil
+}
+
+func (m *MemoryOrganizationRepository) RemoveMember(ctx context.Context, orgID primitive.ObjectID, userID string, updatedAt time.Time) (*model.Organization, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	org, ok := m.orgs[orgID]
+	if !ok {
+		return nil, repoerr.NotFound("organization not found")
+	}
+
+	members := []model.OrgMember{}
+	for _, member := range org.Members {
+		if member.UserID != userID {
+			members = append(members, member)
+		}
+	}
+	if len(members) == len(org.Members) {
+		return nil, repoerr.NotFound("member not found")
+	}
+	org.Members = members
+	org.UpdatedAt = updatedAt
+
+	return cloneOrganization(org), nil
+}
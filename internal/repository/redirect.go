@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RedirectRepository stores the former addresses of repos. An address redirects to
+// at most one repo.
+type RedirectRepository interface {
+	Save(ctx context.Context, redirect *model.Redirect) error
+	Find(ctx context.Context, ownerID string, name string) (*model.Redirect, error)
+	Remove(ctx context.Context, ownerID string, name string) error
+	RemoveByRepo(ctx context.Context, repoID primitive.ObjectID) error
+}
+
+type MongoRedirectRepository struct {
+	Collection MongoCollection
+}
+
+func NewRedirectRepository(collection MongoCollection) *MongoRedirectRepository {
+	return &MongoRedirectRepository{
+		Collection: collection,
+	}
+}
+
+// EnsureIndexes creates the unique (owner_id, name) index and the repo_id index used
+// when a repo is deleted
+func (m *MongoRedirectRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := m.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "name", Value: 1}},
+			Options: options.Index().SetName("owner_id_name_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "repo_id", Value: 1}},
+			Options: options.Index().SetName("repo_id"),
+		},
+	})
+	return mapMongoError(err, "redirect")
+}
+
+// Save points the redirect's address at its repo, replacing an older redirect of
+// the same address
+func (m *MongoRedirectRepository) Save(ctx context.Context, redirect *model.Redirect) error {
+	if redirect.ID.IsZero() {
+		redirect.ID = primitive.NewObjectID()
+	}
+
+	_, err := m.Collection.UpdateOne(ctx,
+		bson.M{"owner_id": redirect.OwnerID, "name": redirect.Name},
+		bson.M{
+			"$set":         bson.M{"repo_id": redirect.RepoID, "created_at": redirect.CreatedAt},
+			"$setOnInsert": bson.M{"_id": redirect.ID},
+		},
+		options.Update().SetUpsert(true),
+	)
+	return mapMongoError(err, "redirect")
+}
+
+func (m *MongoRedirectRepository) Find(ctx context.Context, ownerID string, name string) (*model.Redirect, error) {
+	redirect := &model.Redirect{}
+	err := m.Collection.FindOne(ctx, bson.M{"owner_id": ownerID, "name": name}).Decode(redirect)
+	if err != nil {
+		return nil, mapMongoError(err, "redirect")
+	}
+
+	return redirect, nil
+}
+
+// Remove deletes the redirect of an address, a missing one is not an error
+func (m *MongoRedirectRepository) Remove(ctx context.Context, ownerID string, name string) error {
+	_, err := m.Collection.DeleteOne(ctx, bson.M{"owner_id": ownerID, "name": name})
+	return mapMongoError(err, "redirect")
+}
+
+func (m *MongoRedirectRepository) RemoveByRepo(ctx context.Context, repoID primitive.ObjectID) error {
+	_, err := m.Collection.DeleteMany(ctx, bson.M{"repo_id": repoID})
+	return mapMongoError(err, "redirect")
+}
+
+// MemoryRedirectRepository keeps redirects in process memory
+type MemoryRedirectRepository struct {
+	mu        sync.RWMutex
+	redirects map[redirectKey]*model.Redirect
+}
+
+type redirectKey struct {
+	ownerID string
+	name    string
+}
+
+func NewMemoryRedirectRepository() *MemoryRedirectRepository {
+	return &MemoryRedirectRepository{
+		redirects: map[redirectKey]*model.Redirect{},
+	}
+}
+
+func (m *MemoryRedirectRepository) Save(ctx context.Context, redirect *model.Redirect) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := redirectKey{redirect.OwnerID, redirect.Name}
+	if existing, ok := m.redirects[key]; ok {
+		redirect.ID = existing.ID
+	}
+	if redirect.ID.IsZero() {
+		redirect.ID = primitive.NewObjectID()
+	}
+	copied := *redirect
+	m.redirects[key] = &copied
+
+	return nil
+}
+
+func (m *MemoryRedirectRepository) Find(ctx context.Context, ownerID string, name string) (*model.Redirect, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	redirect, ok := m.redirects[redirectKey{ownerID, name}]
+	if !ok {
+		return nil, repoerr.NotFound("redirect not found")
+	}
+
+	copied := *redirect
+	return &copied, nil
+}
+
+func (m *MemoryRedirectRepository) Remove(ctx context.Context, ownerID string, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.redirects, redirectKey{ownerID, name})
+
+	return nil
+}
+
+func (m *MemoryRedirectRepository) RemoveByRepo(ctx context.Context, repoID primitive.ObjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key, redirect := range m.redirects {
+		if redirect.RepoID == repoID {
+			delete(m.redirects, key)
+		}
+	}
+
+	return nil
+}
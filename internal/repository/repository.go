@@ -2,26 +2,60 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"regexp"
+	"time"
 
 	"github.com/Bit-Bridge-Source/BitBridge-CommonService-Go/public/adapter"
 	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/naming"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 type RepoRepository interface {
 	FindById(ctx context.Context, id string) (*model.PrivateRepoModel, error)
 	FindByName(ctx context.Context, name string) (*model.PrivateRepoModel, error)
+	FindByOwnerAndName(ctx context.Context, ownerID string, name string) (*model.PrivateRepoModel, error)
+	FindByOwnerAndSkeleton(ctx context.Context, ownerID string, skeleton string) (*model.PrivateRepoModel, error)
 	Create(ctx context.Context, repo *model.PrivateRepoModel) (*model.PrivateRepoModel, error)
 	UpdateOne(ctx context.Context, repo *model.PrivateRepoModel) (*model.PrivateRepoModel, error)
+	PatchOne(ctx context.Context, id string, patch *model.RepoPatch) (*model.PrivateRepoModel, error)
 	DeleteOne(ctx context.Context, repo *model.PrivateRepoModel) error
+	List(ctx context.Context, query *model.RepoListQuery) (*model.RepoPage, error)
+	Count(ctx context.Context, query *model.RepoListQuery) (int64, error)
+	Trash(ctx context.Context, id string, deletedAt time.Time) (*model.PrivateRepoModel, error)
+	Restore(ctx context.Context, id string) (*model.PrivateRepoModel, error)
+	FindTrashedById(ctx context.Context, id string) (*model.PrivateRepoModel, error)
+	ListTrashed(ctx context.Context, deletedBefore time.Time) ([]*model.PrivateRepoModel, error)
+	AddForks(ctx context.Context, id string, delta int) error
+	AddStars(ctx context.Context, id string, delta int) error
+	AddWatchers(ctx context.Context, id string, delta int) error
+	SetForks(ctx context.Context, id string, count int64) error
+	SetStars(ctx context.Context, id string, count int64) error
+	SetWatchers(ctx context.Context, id string, count int64) error
+	SetForkLinks(ctx context.Context, id string, parentID *primitive.ObjectID, rootID *primitive.ObjectID) error
+}
+
+// MongoCollection is the part of *mongo.Collection the repository relies on
+type MongoCollection interface {
+	adapter.MongoAdapter
+	Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (*mongo.Cursor, error)
+	FindOneAndUpdate(ctx context.Context, filter interface{}, update interface{}, opts ...*options.FindOneAndUpdateOptions) *mongo.SingleResult
+	UpdateMany(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error)
+	DeleteMany(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error)
+	CountDocuments(ctx context.Context, filter interface{}, opts ...*options.CountOptions) (int64, error)
+	Indexes() mongo.IndexView
 }
 
 type MongoRepoRepository struct {
-	Collection adapter.MongoAdapter
+	Collection MongoCollection
 }
 
-func NewRepoRepository(collection adapter.MongoAdapter) *MongoRepoRepository {
+func NewRepoRepository(collection MongoCollection) *MongoRepoRepository {
 	return &MongoRepoRepository{
 		Collection: collection,
 	}
@@ -30,14 +64,14 @@ func NewRepoRepository(collection adapter.MongoAdapter) *MongoRepoRepository {
 func (m *MongoRepoRepository) FindById(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, err
+		return nil, repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
 	}
 
 	repo := &model.PrivateRepoModel{}
-	err = m.Collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(repo)
+	err = m.Collection.FindOne(ctx, live(bson.M{"_id": objectID})).Decode(repo)
 
 	if err != nil {
-		return nil, err
+		return nil, mapMongoError(err, "repo")
 	}
 
 	return repo, nil
@@ -45,41 +79,576 @@ func (m *MongoRepoRepository) FindById(ctx context.Context, id string) (*model.P
 
 func (m *MongoRepoRepository) FindByName(ctx context.Context, name string) (*model.PrivateRepoModel, error) {
 	repo := &model.PrivateRepoModel{}
-	err := m.Collection.FindOne(ctx, bson.M{"name": name}).Decode(repo)
+	err := m.Collection.FindOne(ctx, live(bson.M{"name": name})).Decode(repo)
 
 	if err != nil {
-		return nil, err
+		return nil, mapMongoError(err, "repo")
 	}
 
 	return repo, nil
 }
 
+func (m *MongoRepoRepository) FindByOwnerAndName(ctx context.Context, ownerID string, name string) (*model.PrivateRepoModel, error) {
+	repo := &model.PrivateRepoModel{}
+	err := m.Collection.FindOne(ctx, live(bson.M{"owner_id": ownerID, "name": name})).Decode(repo)
+
+	if err != nil {
+		return nil, mapMongoError(err, "repo")
+	}
+
+	return repo, nil
+}
+
+// FindByOwnerAndSkeleton also finds repos in the trash, whose names stay reserved
+// until they are purged
+func (m *MongoRepoRepository) FindByOwnerAndSkeleton(ctx context.Context, ownerID string, skeleton string) (*model.PrivateRepoModel, error) {
+	repo := &model.PrivateRepoModel{}
+	err := m.Collection.FindOne(ctx, bson.M{"owner_id": ownerID, "skeleton": skeleton}).Decode(repo)
+
+	if err != nil {
+		return nil, mapMongoError(err, "repo")
+	}
+
+	return repo, nil
+}
+
+// EnsureIndexes creates the indexes the repository relies on, names are unique per
+// owner including the repos in the trash. Repos stored before forks, stars and
+// watchers were counted get zero counts, keyset pages sorted by a count skip
+// missing ones, and repos stored before skeletons get theirs.
+func (m *MongoRepoRepository) EnsureIndexes(ctx context.Context) error {
+	for _, counter := range []string{"forks_count", "stars_count", "watchers_count"} {
+		_, err := m.Collection.UpdateMany(ctx, bson.M{counter: bson.M{"$exists": false}}, bson.M{"$set": bson.M{counter: 0}})
+		if err != nil {
+			return mapMongoError(err, "repo")
+		}
+	}
+
+	if err := m.BackfillSkeletons(ctx); err != nil {
+		return err
+	}
+
+	_, err := m.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "name", Value: 1}},
+			Options: options.Index().SetName("owner_id_name_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "owner_id", Value: 1}, {Key: "skeleton", Value: 1}},
+			Options: options.Index().SetName("owner_id_skeleton"),
+		},
+		{
+			Keys:    bson.D{{Key: "deleted_at", Value: 1}},
+			Options: options.Index().SetName("deleted_at").SetSparse(true),
+		},
+		{
+			Keys:    bson.D{{Key: "parent_id", Value: 1}},
+			Options: options.Index().SetName("parent_id").SetSparse(true),
+		},
+		{
+			Keys:    bson.D{{Key: "root_id", Value: 1}},
+			Options: options.Index().SetName("root_id").SetSparse(true),
+		},
+		{
+			Keys:    bson.D{{Key: "stars_count", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("stars_count"),
+		},
+		{
+			Keys:    bson.D{{Key: "watchers_count", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("watchers_count"),
+		},
+	})
+
+	return mapMongoError(err, "repo")
+}
+
+// BackfillSkeletons stores the skeleton of every repo that has none, without them
+// FindByOwnerAndSkeleton could not see confusable names of repos stored earlier
+func (m *MongoRepoRepository) BackfillSkeletons(ctx context.Context) error {
+	missing := bson.M{"skeleton": bson.M{"$exists": false}}
+	cursor, err := m.Collection.Find(ctx, missing, options.Find().SetProjection(bson.M{"name": 1}))
+	if err != nil {
+		return mapMongoError(err, "repo")
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var document struct {
+			ID   primitive.ObjectID `bson:"_id"`
+			Name string             `bson:"name"`
+		}
+		if err := cursor.Decode(&document); err != nil {
+			return mapMongoError(err, "repo")
+		}
+
+		_, err := m.Collection.UpdateOne(ctx,
+			bson.M{"_id": document.ID, "skeleton": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"skeleton": naming.Skeleton(document.Name)}},
+		)
+		if err != nil {
+			return mapMongoError(err, "repo")
+		}
+	}
+
+	return mapMongoError(cursor.Err(), "repo")
+}
+
 func (m *MongoRepoRepository) Create(ctx context.Context, repo *model.PrivateRepoModel) (*model.PrivateRepoModel, error) {
 	_, err := m.Collection.InsertOne(ctx, repo)
 
 	if err != nil {
-		return nil, err
+		return nil, mapMongoError(err, "repo")
 	}
 
 	return repo, nil
 }
 
+// UpdateOne replaces the repo if its stored version still equals repo.Version and
+// returns it with the bumped version. The fork, star and watcher counts are left as
+// stored.
 func (m *MongoRepoRepository) UpdateOne(ctx context.Context, repo *model.PrivateRepoModel) (*model.PrivateRepoModel, error) {
-	_, err := m.Collection.UpdateOne(ctx, bson.M{"_id": repo.ID}, bson.M{"$set": repo})
+	updated := *repo
+	updated.Version = repo.Version + 1
 
+	document, err := withoutCounts(&updated)
 	if err != nil {
 		return nil, err
 	}
+	result, err := m.Collection.UpdateOne(ctx, versionFilter(repo), bson.M{"$set": document})
+
+	if err != nil {
+		return nil, mapMongoError(err, "repo")
+	}
+
+	if result.MatchedCount == 0 {
+		if _, err := m.FindById(ctx, repo.ID.Hex()); err != nil {
+			return nil, err
+		}
+
+		return nil, repoerr.VersionMismatch()
+	}
+
+	return &updated, nil
+}
+
+// withoutCounts turns repo into a document to $set that leaves the counts alone,
+// they are only changed through their own increments
+func withoutCounts(repo *model.PrivateRepoModel) (bson.M, error) {
+	data, err := bson.Marshal(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	document := bson.M{}
+	if err := bson.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	for _, count := range []string{"forks_count", "stars_count", "watchers_count"} {
+		delete(document, count)
+	}
+
+	return document, nil
+}
+
+// versionFilter matches the repo at the given version; documents written before
+// versioning existed have no version field and count as version 0
+func versionFilter(repo *model.PrivateRepoModel) bson.M {
+	if repo.Version == 0 {
+		return live(bson.M{"_id": repo.ID, "version": bson.M{"$in": bson.A{0, nil}}})
+	}
+
+	return live(bson.M{"_id": repo.ID, "version": repo.Version})
+}
+
+// live narrows a filter to repos outside the trash
+func live(filter bson.M) bson.M {
+	filter["deleted_at"] = nil
+	return filter
+}
+
+// trashed narrows a filter to repos in the trash
+func trashed(filter bson.M) bson.M {
+	filter["deleted_at"] = bson.M{"$ne": nil}
+	return filter
+}
+
+// PatchOne sets only the patched fields and returns the repo as stored afterwards
+func (m *MongoRepoRepository) PatchOne(ctx context.Context, id string, patch *model.RepoPatch) (*model.PrivateRepoModel, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
+	}
+
+	filter := live(bson.M{"_id": objectID})
+	if patch.Version != 0 {
+		filter["version"] = patch.Version
+	}
+
+	set := bson.M{"updated_at": patch.UpdatedAt}
+	if patch.Name != nil {
+		set["name"] = *patch.Name
+		set["skeleton"] = patch.Skeleton
+	}
+	if patch.Description != nil {
+		set["description"] = *patch.Description
+	}
+	if patch.Visibility != nil {
+		set["visibility"] = *patch.Visibility
+	}
+	if patch.OwnerID != nil {
+		set["owner_id"] = *patch.OwnerID
+		set["owner_type"] = patch.OwnerType
+	}
+	if patch.Topics != nil {
+		set["topics"] = *patch.Topics
+	}
+	if patch.Properties != nil {
+		set["properties"] = *patch.Properties
+	}
+	if patch.IsTemplate != nil {
+		set["is_template"] = *patch.IsTemplate
+	}
+
+	update := bson.M{"$set": set, "$inc": bson.M{"version": 1}}
+	if patch.Archived != nil {
+		if *patch.Archived {
+			set["archived_at"] = patch.UpdatedAt
+		} else {
+			update["$unset"] = bson.M{"archived_at": ""}
+		}
+	}
+	if patch.PreviousName != "" {
+		update["$addToSet"] = bson.M{"previous_names": patch.PreviousName}
+	}
+
+	repo := &model.PrivateRepoModel{}
+	err = m.Collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(repo)
+
+	if errors.Is(err, mongo.ErrNoDocuments) && patch.Version != 0 {
+		if _, err := m.FindById(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return nil, repoerr.VersionMismatch()
+	}
+
+	if err != nil {
+		return nil, mapMongoError(err, "repo")
+	}
 
 	return repo, nil
 }
 
+// DeleteOne removes a repo for good, whether it is in the trash or not
 func (m *MongoRepoRepository) DeleteOne(ctx context.Context, repo *model.PrivateRepoModel) error {
-	_, err := m.Collection.DeleteOne(ctx, bson.M{"_id": repo.ID})
+	result, err := m.Collection.DeleteOne(ctx, bson.M{"_id": repo.ID})
 
 	if err != nil {
-		return err
+		return mapMongoError(err, "repo")
+	}
+
+	if result.DeletedCount == 0 {
+		return repoerr.NotFound("repo not found")
+	}
+
+	return nil
+}
+
+// Trash moves a repo to the trash, where only FindTrashedById and ListTrashed find it
+func (m *MongoRepoRepository) Trash(ctx context.Context, id string, deletedAt time.Time) (*model.PrivateRepoModel, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
+	}
+
+	return m.findOneAndUpdate(ctx, live(bson.M{"_id": objectID}),
+		bson.M{"$set": bson.M{"deleted_at": deletedAt}, "$inc": bson.M{"version": 1}})
+}
+
+// AddForks changes the fork count of a repo by delta, whether it is in the trash or
+// not. The version is left alone, forks come and go without changing the repo itself.
+func (m *MongoRepoRepository) AddForks(ctx context.Context, id string, delta int) error {
+	return m.updateCount(ctx, id, bson.M{"$inc": bson.M{"forks_count": delta}})
+}
+
+// SetForks overwrites the fork count of a repo, to bring it back in line with its forks
+func (m *MongoRepoRepository) SetForks(ctx context.Context, id string, count int64) error {
+	return m.updateCount(ctx, id, bson.M{"$set": bson.M{"forks_count": count}})
+}
+
+// AddStars changes the star count of a repo by delta, like AddForks
+func (m *MongoRepoRepository) AddStars(ctx context.Context, id string, delta int) error {
+	return m.updateCount(ctx, id, bson.M{"$inc": bson.M{"stars_count": delta}})
+}
+
+// SetStars overwrites the star count of a repo, like SetForks
+func (m *MongoRepoRepository) SetStars(ctx context.Context, id string, count int64) error {
+	return m.updateCount(ctx, id, bson.M{"$set": bson.M{"stars_count": count}})
+}
+
+// AddWatchers changes the watcher count of a repo by delta, like AddForks
+func (m *MongoRepoRepository) AddWatchers(ctx context.Context, id string, delta int) error {
+	return m.updateCount(ctx, id, bson.M{"$inc": bson.M{"watchers_count": delta}})
+}
+
+// SetWatchers overwrites the watcher count of a repo, like SetForks
+func (m *MongoRepoRepository) SetWatchers(ctx context.Context, id string, count int64) error {
+	return m.updateCount(ctx, id, bson.M{"$set": bson.M{"watchers_count": count}})
+}
+
+func (m *MongoRepoRepository) updateCount(ctx context.Context, id string, update bson.M) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
+	}
+
+	result, err := m.Collection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return mapMongoError(err, "repo")
+	}
+
+	if result.MatchedCount == 0 {
+		return repoerr.NotFound("repo not found")
 	}
 
 	return nil
 }
+
+// SetForkLinks moves a repo within or out of a fork network, nil IDs are removed
+func (m *MongoRepoRepository) SetForkLinks(ctx context.Context, id string, parentID *primitive.ObjectID, rootID *primitive.ObjectID) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
+	}
+
+	set, unset := bson.M{}, bson.M{}
+	for key, value := range map[string]*primitive.ObjectID{"parent_id": parentID, "root_id": rootID} {
+		if value == nil {
+			unset[key] = ""
+		} else {
+			set[key] = *value
+		}
+	}
+
+	update := bson.M{"$inc": bson.M{"version": 1}}
+	if len(set) > 0 {
+		update["$set"] = set
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	result, err := m.Collection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return mapMongoError(err, "repo")
+	}
+
+	if result.MatchedCount == 0 {
+		return repoerr.NotFound("repo not found")
+	}
+
+	return nil
+}
+
+// Restore takes a repo back out of the trash
+func (m *MongoRepoRepository) Restore(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
+	}
+
+	return m.findOneAndUpdate(ctx, trashed(bson.M{"_id": objectID}),
+		bson.M{"$unset": bson.M{"deleted_at": ""}, "$inc": bson.M{"version": 1}})
+}
+
+func (m *MongoRepoRepository) findOneAndUpdate(ctx context.Context, filter bson.M, update bson.M) (*model.PrivateRepoModel, error) {
+	repo := &model.PrivateRepoModel{}
+	err := m.Collection.FindOneAndUpdate(ctx, filter, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(repo)
+
+	if err != nil {
+		return nil, mapMongoError(err, "repo")
+	}
+
+	return repo, nil
+}
+
+func (m *MongoRepoRepository) FindTrashedById(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
+	}
+
+	repo := &model.PrivateRepoModel{}
+	err = m.Collection.FindOne(ctx, trashed(bson.M{"_id": objectID})).Decode(repo)
+
+	if err != nil {
+		return nil, mapMongoError(err, "repo")
+	}
+
+	return repo, nil
+}
+
+// ListTrashed returns the repos moved to the trash before deletedBefore, oldest first
+func (m *MongoRepoRepository) ListTrashed(ctx context.Context, deletedBefore time.Time) ([]*model.PrivateRepoModel, error) {
+	cursor, err := m.Collection.Find(ctx, bson.M{"deleted_at": bson.M{"$lt": deletedBefore}},
+		options.Find().SetSort(bson.D{{Key: "deleted_at", Value: 1}, {Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, mapMongoError(err, "repo")
+	}
+
+	repos := []*model.PrivateRepoModel{}
+	if err := cursor.All(ctx, &repos); err != nil {
+		return nil, mapMongoError(err, "repo")
+	}
+
+	return repos, nil
+}
+
+func (m *MongoRepoRepository) List(ctx context.Context, query *model.RepoListQuery) (*model.RepoPage, error) {
+	position, err := decodeCursor(query)
+	if err != nil {
+		return nil, err
+	}
+
+	key := sortKey(query)
+	direction := 1
+	if query.Descending {
+		direction = -1
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: key, Value: direction}, {Key: "_id", Value: direction}})
+	if query.Limit > 0 {
+		opts.SetLimit(int64(query.Limit) + 1)
+	}
+
+	cursor, err := m.Collection.Find(ctx, listFilter(query, key, position), opts)
+	if err != nil {
+		return nil, mapMongoError(err, "repo")
+	}
+
+	repos := []*model.PrivateRepoModel{}
+	if err := cursor.All(ctx, &repos); err != nil {
+		return nil, mapMongoError(err, "repo")
+	}
+
+	return newPage(query, repos), nil
+}
+
+// Count counts the repos List returns for query over all pages, its cursor and limit
+// are ignored
+func (m *MongoRepoRepository) Count(ctx context.Context, query *model.RepoListQuery) (int64, error) {
+	count, err := m.Collection.CountDocuments(ctx, listFilter(query, sortKey(query), nil))
+	if err != nil {
+		return 0, mapMongoError(err, "repo")
+	}
+
+	return count, nil
+}
+
+func listFilter(query *model.RepoListQuery, key string, position *cursorPosition) bson.M {
+	conditions := []bson.M{live(bson.M{})}
+
+	if query.OwnerID != "" {
+		conditions = append(conditions, bson.M{"owner_id": query.OwnerID})
+	}
+	if query.Name != "" {
+		conditions = append(conditions, bson.M{"name": query.Name})
+	}
+	if query.NamePrefix != "" {
+		conditions = append(conditions, bson.M{"name": bson.M{"$regex": "^" + regexp.QuoteMeta(query.NamePrefix)}})
+	}
+	if timeRange := rangeFilter(query.CreatedAfter, query.CreatedBefore); timeRange != nil {
+		conditions = append(conditions, bson.M{"created_at": timeRange})
+	}
+	if timeRange := rangeFilter(query.UpdatedAfter, query.UpdatedBefore); timeRange != nil {
+		conditions = append(conditions, bson.M{"updated_at": timeRange})
+	}
+	if !query.ParentID.IsZero() {
+		conditions = append(conditions, bson.M{"parent_id": query.ParentID})
+	}
+	if !query.NetworkID.IsZero() {
+		conditions = append(conditions, bson.M{"$or": []bson.M{{"_id": query.NetworkID, "root_id": nil}, {"root_id": query.NetworkID}}})
+	}
+	if len(query.IDs) > 0 {
+		conditions = append(conditions, bson.M{"_id": bson.M{"$in": query.IDs}})
+	}
+	if query.Archived != nil {
+		if *query.Archived {
+			conditions = append(conditions, bson.M{"archived_at": bson.M{"$ne": nil}})
+		} else {
+			conditions = append(conditions, bson.M{"archived_at": nil})
+		}
+	}
+
+	if query.Access != nil {
+		conditions = append(conditions, accessFilter(query.Access))
+	}
+
+	if position != nil {
+		operator := "$gt"
+		if query.Descending {
+			operator = "$lt"
+		}
+
+		conditions = append(conditions, bson.M{"$or": []bson.M{
+			{key: bson.M{operator: position.value}},
+			{key: position.value, "_id": bson.M{operator: position.id}},
+		}})
+	}
+
+	return bson.M{"$and": conditions}
+}
+
+// accessFilter mirrors RepoAccess.Allows, a missing visibility counts as public
+func accessFilter(access *model.RepoAccess) bson.M {
+	visibilities := bson.A{}
+	for _, visibility := range access.Visibilities {
+		visibilities = append(visibilities, visibility)
+		if visibility == model.VisibilityPublic {
+			visibilities = append(visibilities, nil)
+		}
+	}
+
+	anyOf := []bson.M{{"visibility": bson.M{"$in": visibilities}}}
+	if access.MemberID != "" {
+		anyOf = append(anyOf, bson.M{"owner_id": access.MemberID})
+	}
+	if len(access.RepoIDs) > 0 {
+		anyOf = append(anyOf, bson.M{"_id": bson.M{"$in": access.RepoIDs}})
+	}
+	if len(access.OrgIDs) > 0 {
+		anyOf = append(anyOf, bson.M{"owner_type": model.OwnerOrganization, "owner_id": bson.M{"$in": access.OrgIDs}})
+	}
+
+	return bson.M{"$or": anyOf}
+}
+
+func rangeFilter(after, before time.Time) bson.M {
+	timeRange := bson.M{}
+	if !after.IsZero() {
+		timeRange["$gte"] = after
+	}
+	if !before.IsZero() {
+		timeRange["$lt"] = before
+	}
+
+	if len(timeRange) == 0 {
+		return nil
+	}
+
+	return timeRange
+}
+
+// newPage trims the extra repo fetched to detect a following page
+func newPage(query *model.RepoListQuery, repos []*model.PrivateRepoModel) *model.RepoPage {
+	page := &model.RepoPage{Repos: repos}
+
+	if query.Limit > 0 && len(repos) > query.Limit {
+		page.Repos = repos[:query.Limit]
+		page.NextCursor = encodeCursor(query, page.Repos[query.Limit-1])
+	}
+
+	return page
+}
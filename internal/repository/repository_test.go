@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/naming"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
 	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -35,11 +37,41 @@ func (m *MongoAdapterMock) DeleteOne(ctx context.Context, filter interface{}, op
 	return args.Get(0).(*mongo.DeleteResult), args.Error(1)
 }
 
+func (m *MongoAdapterMock) UpdateMany(ctx context.Context, filter interface{}, update interface{}, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	args := m.Called(ctx, filter, update, opts)
+	return args.Get(0).(*mongo.UpdateResult), args.Error(1)
+}
+
+func (m *MongoAdapterMock) DeleteMany(ctx context.Context, filter interface{}, opts ...*options.DeleteOptions) (*mongo.DeleteResult, error) {
+	args := m.Called(ctx, filter, opts)
+	return args.Get(0).(*mongo.DeleteResult), args.Error(1)
+}
+
+func (m *MongoAdapterMock) CountDocuments(ctx context.Context, filter interface{}, opts ...*options.CountOptions) (int64, error) {
+	args := m.Called(ctx, filter, opts)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MongoAdapterMock) FindOne(ctx context.Context, filter interface{}, opts ...*options.FindOneOptions) *mongo.SingleResult {
 	args := m.Called(ctx, filter, opts)
 	return args.Get(0).(*mongo.SingleResult)
 }
 
+func (m *MongoAdapterMock) FindOneAndUpdate(ctx context.Context, filter interface{}, update interface{}, opts ...*options.FindOneAndUpdateOptions) *mongo.SingleResult {
+	args := m.Called(ctx, filter, update, opts)
+	return args.Get(0).(*mongo.SingleResult)
+}
+
+func (m *MongoAdapterMock) Find(ctx context.Context, filter interface{}, opts ...*options.FindOptions) (*mongo.Cursor, error) {
+	args := m.Called(ctx, filter, opts)
+	return args.Get(0).(*mongo.Cursor), args.Error(1)
+}
+
+func (m *MongoAdapterMock) Indexes() mongo.IndexView {
+	args := m.Called()
+	return args.Get(0).(mongo.IndexView)
+}
+
 type SingleResultWrapper struct {
 	decoder SingleResultDecoder
 }
@@ -123,7 +155,7 @@ func TestFindById_Sucess(t *testing.T) {
 
 	sr := mongo.NewSingleResultFromDocument(repoExpected, nil, bson.DefaultRegistry)
 
-	adapterMock.On("FindOne", ctx, bson.M{"_id": repoExpected.ID}, mock.Anything).Return(sr)
+	adapterMock.On("FindOne", ctx, bson.M{"_id": repoExpected.ID, "deleted_at": nil}, mock.Anything).Return(sr)
 
 	repo, err := repository.FindById(ctx, repoExpected.ID.Hex())
 
@@ -154,7 +186,7 @@ func TestFindById_Error_NotFound(t *testing.T) {
 
 	sr := mongo.NewSingleResultFromDocument(&model.PrivateRepoModel{}, errors.New("not found"), bson.DefaultRegistry)
 
-	adapterMock.On("FindOne", ctx, bson.M{"_id": id}, mock.Anything).Return(sr)
+	adapterMock.On("FindOne", ctx, bson.M{"_id": id, "deleted_at": nil}, mock.Anything).Return(sr)
 
 	repository := repository.NewRepoRepository(adapterMock)
 
@@ -181,7 +213,7 @@ func TestFindByName_Sucess(t *testing.T) {
 
 	sr := mongo.NewSingleResultFromDocument(repoExpected, nil, bson.DefaultRegistry)
 
-	adapterMock.On("FindOne", ctx, bson.M{"name": repoExpected.Name}, mock.Anything).Return(sr)
+	adapterMock.On("FindOne", ctx, bson.M{"name": repoExpected.Name, "deleted_at": nil}, mock.Anything).Return(sr)
 
 	repo, err := repository.FindByName(ctx, repoExpected.Name)
 
@@ -199,7 +231,7 @@ func TestFindByName_Error_NotFound(t *testing.T) {
 
 	sr := mongo.NewSingleResultFromDocument(&model.PrivateRepoModel{}, errors.New("not found"), bson.DefaultRegistry)
 
-	adapterMock.On("FindOne", ctx, bson.M{"name": name}, mock.Anything).Return(sr)
+	adapterMock.On("FindOne", ctx, bson.M{"name": name, "deleted_at": nil}, mock.Anything).Return(sr)
 
 	repository := repository.NewRepoRepository(adapterMock)
 
@@ -224,12 +256,20 @@ func TestUpdateOne_Sucess(t *testing.T) {
 		UpdatedAt:   time.Now(),
 	}
 
-	adapterMock.On("UpdateOne", ctx, bson.M{"_id": repoExpected.ID}, bson.M{"$set": repoExpected}, mock.Anything).Return(&mongo.UpdateResult{}, nil)
+	leavesCounts := mock.MatchedBy(func(update bson.M) bool {
+		set := update["$set"].(bson.M)
+		_, forks := set["forks_count"]
+		_, stars := set["stars_count"]
+		_, watchers := set["watchers_count"]
+		return set["version"] == int64(1) && set["name"] == "test" && !forks && !stars && !watchers
+	})
+	adapterMock.On("UpdateOne", ctx, bson.M{"_id": repoExpected.ID, "version": bson.M{"$in": bson.A{0, nil}}, "deleted_at": nil}, leavesCounts, mock.Anything).Return(&mongo.UpdateResult{MatchedCount: 1}, nil)
 
 	repo, err := repository.UpdateOne(ctx, repoExpected)
 
 	assert.Nil(t, err)
 	assert.Equal(t, repoExpected.ID, repo.ID)
+	assert.Equal(t, int64(1), repo.Version)
 
 	adapterMock.AssertExpectations(t)
 }
@@ -248,7 +288,7 @@ func TestUpdateOne_Error_NotFound(t *testing.T) {
 		UpdatedAt:   time.Now(),
 	}
 
-	adapterMock.On("UpdateOne", ctx, bson.M{"_id": repoExpected.ID}, bson.M{"$set": repoExpected}, mock.Anything).Return(&mongo.UpdateResult{}, mongo.ErrNoDocuments)
+	adapterMock.On("UpdateOne", ctx, mock.Anything, mock.Anything, mock.Anything).Return(&mongo.UpdateResult{}, mongo.ErrNoDocuments)
 
 	_, err := repository.UpdateOne(ctx, repoExpected)
 
@@ -271,7 +311,7 @@ func TestDeleteOne_Sucess(t *testing.T) {
 		UpdatedAt:   time.Now(),
 	}
 
-	adapterMock.On("DeleteOne", ctx, bson.M{"_id": repoExpected.ID}, mock.Anything).Return(&mongo.DeleteResult{}, nil)
+	adapterMock.On("DeleteOne", ctx, bson.M{"_id": repoExpected.ID}, mock.Anything).Return(&mongo.DeleteResult{DeletedCount: 1}, nil)
 
 	err := repository.DeleteOne(ctx, repoExpected)
 
@@ -302,3 +342,330 @@ func TestDeleteOne_Error_NotFound(t *testing.T) {
 
 	adapterMock.AssertExpectations(t)
 }
+
+func TestFindById_Error_InvalidID_Kind(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+
+	_, err := repository.FindById(ctx, "invalid")
+
+	assert.ErrorIs(t, err, repoerr.ErrInvalidID)
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestFindByName_Error_NotFound_Kind(t *testing.T) {
+	ctx := context.TODO()
+
+	name := "test"
+	adapterMock := new(MongoAdapterMock)
+
+	sr := mongo.NewSingleResultFromDocument(&model.PrivateRepoModel{}, mongo.ErrNoDocuments, bson.DefaultRegistry)
+
+	adapterMock.On("FindOne", ctx, bson.M{"name": name, "deleted_at": nil}, mock.Anything).Return(sr)
+
+	repository := repository.NewRepoRepository(adapterMock)
+
+	_, err := repository.FindByName(ctx, name)
+
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+	assert.NotErrorIs(t, err, repoerr.ErrConflict)
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestCreateRepo_Error_Duplicate_Kind(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+	repoToBeCreated := &model.PrivateRepoModel{
+		ID:   primitive.NewObjectID(),
+		Name: "test",
+	}
+
+	duplicate := mongo.WriteException{WriteErrors: []mongo.WriteError{{Code: 11000}}}
+	adapterMock.On("InsertOne", ctx, repoToBeCreated, mock.Anything).Return(&mongo.InsertOneResult{}, duplicate)
+
+	_, err := repository.Create(ctx, repoToBeCreated)
+
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestUpdateOne_Error_NoMatch(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+	repoExpected := &model.PrivateRepoModel{
+		ID:   primitive.NewObjectID(),
+		Name: "test",
+	}
+
+	adapterMock.On("UpdateOne", ctx, mock.Anything, mock.Anything, mock.Anything).Return(&mongo.UpdateResult{}, nil)
+
+	sr := mongo.NewSingleResultFromDocument(&model.PrivateRepoModel{}, mongo.ErrNoDocuments, bson.DefaultRegistry)
+	adapterMock.On("FindOne", ctx, bson.M{"_id": repoExpected.ID, "deleted_at": nil}, mock.Anything).Return(sr)
+
+	_, err := repository.UpdateOne(ctx, repoExpected)
+
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestDeleteOne_Error_NoMatch(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+	repoExpected := &model.PrivateRepoModel{
+		ID:   primitive.NewObjectID(),
+		Name: "test",
+	}
+
+	adapterMock.On("DeleteOne", ctx, bson.M{"_id": repoExpected.ID}, mock.Anything).Return(&mongo.DeleteResult{}, nil)
+
+	err := repository.DeleteOne(ctx, repoExpected)
+
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestList_Success(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+	ownerID := primitive.NewObjectID().Hex()
+	repos := []interface{}{
+		&model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: "a", OwnerID: ownerID},
+		&model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: "b", OwnerID: ownerID},
+		&model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: "c", OwnerID: ownerID},
+	}
+	cursor, err := mongo.NewCursorFromDocuments(repos, nil, bson.DefaultRegistry)
+	assert.Nil(t, err)
+
+	filter := bson.M{"$and": []bson.M{{"deleted_at": nil}, {"owner_id": ownerID}, {"name": bson.M{"$regex": "^a\\.b"}}}}
+	adapterMock.On("Find", ctx, filter, mock.Anything).Return(cursor, nil)
+
+	page, err := repository.List(ctx, &model.RepoListQuery{OwnerID: ownerID, NamePrefix: "a.b", SortBy: model.SortByName, Limit: 2})
+
+	assert.Nil(t, err)
+	assert.Len(t, page.Repos, 2)
+	assert.NotEmpty(t, page.NextCursor)
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestBackfillSkeletons(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+	id := primitive.NewObjectID()
+	cursor, err := mongo.NewCursorFromDocuments([]interface{}{bson.M{"_id": id, "name": "paypa1"}}, nil, bson.DefaultRegistry)
+	assert.Nil(t, err)
+
+	missing := bson.M{"skeleton": bson.M{"$exists": false}}
+	adapterMock.On("Find", ctx, missing, mock.Anything).Return(cursor, nil)
+	adapterMock.On("UpdateOne", ctx, bson.M{"_id": id, "skeleton": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"skeleton": naming.Skeleton("paypal")}}, mock.Anything).
+		Return(&mongo.UpdateResult{MatchedCount: 1, ModifiedCount: 1}, nil)
+
+	err = repository.BackfillSkeletons(ctx)
+
+	assert.Nil(t, err)
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestList_Error_InvalidCursor(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+
+	_, err := repository.List(ctx, &model.RepoListQuery{Cursor: "not a cursor"})
+
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestFindByOwnerAndName_Sucess(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+	repoExpected := &model.PrivateRepoModel{
+		ID:      primitive.NewObjectID(),
+		Name:    "test",
+		OwnerID: primitive.NewObjectID().Hex(),
+	}
+
+	sr := mongo.NewSingleResultFromDocument(repoExpected, nil, bson.DefaultRegistry)
+
+	adapterMock.On("FindOne", ctx, bson.M{"owner_id": repoExpected.OwnerID, "name": repoExpected.Name, "deleted_at": nil}, mock.Anything).Return(sr)
+
+	repo, err := repository.FindByOwnerAndName(ctx, repoExpected.OwnerID, repoExpected.Name)
+
+	assert.Nil(t, err)
+	assert.Equal(t, repoExpected.ID, repo.ID)
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestUpdateOne_Error_StaleVersion(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+	repoExpected := &model.PrivateRepoModel{
+		ID:      primitive.NewObjectID(),
+		Name:    "test",
+		Version: 3,
+	}
+
+	adapterMock.On("UpdateOne", ctx, bson.M{"_id": repoExpected.ID, "version": int64(3), "deleted_at": nil}, mock.Anything, mock.Anything).Return(&mongo.UpdateResult{}, nil)
+
+	stored := *repoExpected
+	stored.Version = 4
+	sr := mongo.NewSingleResultFromDocument(&stored, nil, bson.DefaultRegistry)
+	adapterMock.On("FindOne", ctx, bson.M{"_id": repoExpected.ID, "deleted_at": nil}, mock.Anything).Return(sr)
+
+	_, err := repository.UpdateOne(ctx, repoExpected)
+
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+	assert.ErrorIs(t, err, repoerr.ErrVersionMismatch)
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestPatchOne_Success(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+	id := primitive.NewObjectID()
+	now := time.Now()
+	description := "patched"
+
+	stored := &model.PrivateRepoModel{ID: id, Name: "test", Description: description, Version: 2}
+	sr := mongo.NewSingleResultFromDocument(stored, nil, bson.DefaultRegistry)
+	adapterMock.On("FindOneAndUpdate", ctx, bson.M{"_id": id, "version": int64(1), "deleted_at": nil}, bson.M{
+		"$set": bson.M{"description": description, "updated_at": now},
+		"$inc": bson.M{"version": 1},
+	}, mock.Anything).Return(sr)
+
+	repo, err := repository.PatchOne(ctx, id.Hex(), &model.RepoPatch{Description: &description, Version: 1, UpdatedAt: now})
+
+	assert.Nil(t, err)
+	assert.Equal(t, "patched", repo.Description)
+	assert.Equal(t, int64(2), repo.Version)
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestPatchOne_Unarchive(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+	id := primitive.NewObjectID()
+	now := time.Now()
+	archived := false
+
+	sr := mongo.NewSingleResultFromDocument(&model.PrivateRepoModel{ID: id, Version: 2}, nil, bson.DefaultRegistry)
+	adapterMock.On("FindOneAndUpdate", ctx, bson.M{"_id": id, "version": int64(1), "deleted_at": nil}, bson.M{
+		"$set":   bson.M{"updated_at": now},
+		"$unset": bson.M{"archived_at": ""},
+		"$inc":   bson.M{"version": 1},
+	}, mock.Anything).Return(sr)
+
+	repo, err := repository.PatchOne(ctx, id.Hex(), &model.RepoPatch{Archived: &archived, Version: 1, UpdatedAt: now})
+
+	assert.Nil(t, err)
+	assert.False(t, repo.Archived())
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestPatchOne_Error_StaleVersion(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+	id := primitive.NewObjectID()
+
+	missing := mongo.NewSingleResultFromDocument(&model.PrivateRepoModel{}, mongo.ErrNoDocuments, bson.DefaultRegistry)
+	adapterMock.On("FindOneAndUpdate", ctx, mock.Anything, mock.Anything, mock.Anything).Return(missing)
+
+	stored := mongo.NewSingleResultFromDocument(&model.PrivateRepoModel{ID: id, Version: 5}, nil, bson.DefaultRegistry)
+	adapterMock.On("FindOne", ctx, bson.M{"_id": id, "deleted_at": nil}, mock.Anything).Return(stored)
+
+	_, err := repository.PatchOne(ctx, id.Hex(), &model.RepoPatch{Version: 4})
+
+	assert.ErrorIs(t, err, repoerr.ErrVersionMismatch)
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestTrash_Success(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+	id := primitive.NewObjectID()
+	now := time.Now()
+
+	stored := &model.PrivateRepoModel{ID: id, Name: "test", Version: 2, DeletedAt: &now}
+	sr := mongo.NewSingleResultFromDocument(stored, nil, bson.DefaultRegistry)
+	adapterMock.On("FindOneAndUpdate", ctx, bson.M{"_id": id, "deleted_at": nil}, bson.M{
+		"$set": bson.M{"deleted_at": now},
+		"$inc": bson.M{"version": 1},
+	}, mock.Anything).Return(sr)
+
+	repo, err := repository.Trash(ctx, id.Hex(), now)
+
+	assert.Nil(t, err)
+	assert.True(t, repo.Trashed())
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestRestore_Error_NotTrashed(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	repository := repository.NewRepoRepository(adapterMock)
+	id := primitive.NewObjectID()
+
+	missing := mongo.NewSingleResultFromDocument(&model.PrivateRepoModel{}, mongo.ErrNoDocuments, bson.DefaultRegistry)
+	adapterMock.On("FindOneAndUpdate", ctx, bson.M{"_id": id, "deleted_at": bson.M{"$ne": nil}}, mock.Anything, mock.Anything).Return(missing)
+
+	_, err := repository.Restore(ctx, id.Hex())
+
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	adapterMock.AssertExpectations(t)
+}
+
+func TestRedirectFind_Error_NotFound(t *testing.T) {
+	ctx := context.TODO()
+	adapterMock := new(MongoAdapterMock)
+
+	sr := mongo.NewSingleResultFromDocument(&model.Redirect{}, mongo.ErrNoDocuments, bson.DefaultRegistry)
+	adapterMock.On("FindOne", ctx, bson.M{"owner_id": "owner", "name": "old"}, mock.Anything).Return(sr)
+
+	_, err := repository.NewRedirectRepository(adapterMock).Find(ctx, "owner", "old")
+
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+	assert.Contains(t, err.Error(), "redirect not found")
+
+	adapterMock.AssertExpectations(t)
+}
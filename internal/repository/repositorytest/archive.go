@@ -0,0 +1,70 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func mustSetArchived(t *testing.T, repo repository.RepoRepository, id primitive.ObjectID, archived bool, at time.Time) *model.PrivateRepoModel {
+	patched, err := repo.PatchOne(context.Background(), id.Hex(), &model.RepoPatch{Archived: &archived, UpdatedAt: at})
+	require.NoError(t, err)
+
+	return patched
+}
+
+// Archiving stamps the patch's UpdatedAt as archived_at, unarchiving clears it
+func testPatchOneArchive(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+	archivedAt := time.Now().UTC().Truncate(time.Millisecond)
+
+	archived := mustSetArchived(t, repo, created.ID, true, archivedAt)
+	require.True(t, archived.Archived())
+	assert.True(t, archivedAt.Equal(*archived.ArchivedAt))
+	assert.Equal(t, created.Version+1, archived.Version)
+
+	found, err := repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	require.True(t, found.Archived())
+	assert.True(t, archivedAt.Equal(*found.ArchivedAt))
+	assert.Equal(t, created.Description, found.Description)
+
+	unarchived := mustSetArchived(t, repo, created.ID, false, archivedAt.Add(time.Minute))
+	assert.False(t, unarchived.Archived())
+
+	found, err = repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.False(t, found.Archived())
+}
+
+func testListArchived(t *testing.T, repo repository.RepoRepository) {
+	ownerID := primitive.NewObjectID().Hex()
+	for _, name := range []string{"active", "archived"} {
+		toCreate := NewRepo(name)
+		toCreate.OwnerID = ownerID
+		created := mustCreate(t, repo, toCreate)
+		if name == "archived" {
+			mustSetArchived(t, repo, created.ID, true, time.Now())
+		}
+	}
+
+	archived, active := true, false
+	for _, c := range []struct {
+		archived *bool
+		expected []string
+	}{
+		{nil, []string{"active", "archived"}},
+		{&archived, []string{"archived"}},
+		{&active, []string{"active"}},
+	} {
+		page, err := repo.List(context.Background(), &model.RepoListQuery{OwnerID: ownerID, SortBy: model.SortByName, Archived: c.archived})
+		require.NoError(t, err)
+		assert.Equal(t, c.expected, names(page.Repos))
+	}
+}
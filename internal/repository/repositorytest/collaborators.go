@@ -0,0 +1,163 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CollaboratorFactory returns an empty backend; it is called once per conformance case
+type CollaboratorFactory func(t *testing.T) repository.CollaboratorRepository
+
+// RunCollaboratorConformance holds the behaviour every CollaboratorRepository
+// backend has to share with MongoCollaboratorRepository
+func RunCollaboratorConformance(t *testing.T, factory CollaboratorFactory) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, collaborators repository.CollaboratorRepository)
+	}{
+		{"AddAndFind", testCollaboratorAddAndFind},
+		{"Add_Duplicate", testCollaboratorAddDuplicate},
+		{"Find_Missing", testCollaboratorFindMissing},
+		{"ListByRepo", testCollaboratorListByRepo},
+		{"ListRepoIDsByUser", testCollaboratorListRepoIDsByUser},
+		{"UpdateRole", testCollaboratorUpdateRole},
+		{"Remove", testCollaboratorRemove},
+		{"RemoveByRepo", testCollaboratorRemoveByRepo},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			c.run(t, factory(t))
+		})
+	}
+}
+
+// NewCollaborator builds a collaborator; timestamps are truncated to what every
+// backend can store
+func NewCollaborator(repoID primitive.ObjectID, userID string, role string) *model.Collaborator {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	return &model.Collaborator{
+		RepoID:    repoID,
+		UserID:    userID,
+		Role:      role,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func mustAdd(t *testing.T, collaborators repository.CollaboratorRepository, collaborator *model.Collaborator) *model.Collaborator {
+	added, err := collaborators.Add(context.Background(), collaborator)
+	require.NoError(t, err)
+
+	return added
+}
+
+func testCollaboratorAddAndFind(t *testing.T, collaborators repository.CollaboratorRepository) {
+	added := mustAdd(t, collaborators, NewCollaborator(primitive.NewObjectID(), "user", model.CollaboratorWrite))
+	assert.False(t, added.ID.IsZero())
+
+	found, err := collaborators.Find(context.Background(), added.RepoID, "user")
+	require.NoError(t, err)
+	assert.Equal(t, added.ID, found.ID)
+	assert.Equal(t, model.CollaboratorWrite, found.Role)
+	assert.True(t, added.CreatedAt.Equal(found.CreatedAt))
+}
+
+func testCollaboratorAddDuplicate(t *testing.T, collaborators repository.CollaboratorRepository) {
+	added := mustAdd(t, collaborators, NewCollaborator(primitive.NewObjectID(), "user", model.CollaboratorRead))
+
+	_, err := collaborators.Add(context.Background(), NewCollaborator(added.RepoID, "user", model.CollaboratorAdmin))
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+
+	// The same user may collaborate on another repo
+	mustAdd(t, collaborators, NewCollaborator(primitive.NewObjectID(), "user", model.CollaboratorRead))
+}
+
+func testCollaboratorFindMissing(t *testing.T, collaborators repository.CollaboratorRepository) {
+	_, err := collaborators.Find(context.Background(), primitive.NewObjectID(), "user")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testCollaboratorListByRepo(t *testing.T, collaborators repository.CollaboratorRepository) {
+	repoID := primitive.NewObjectID()
+	mustAdd(t, collaborators, NewCollaborator(repoID, "first", model.CollaboratorRead))
+	mustAdd(t, collaborators, NewCollaborator(repoID, "second", model.CollaboratorAdmin))
+	mustAdd(t, collaborators, NewCollaborator(primitive.NewObjectID(), "elsewhere", model.CollaboratorRead))
+
+	listed, err := collaborators.ListByRepo(context.Background(), repoID)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Equal(t, "first", listed[0].UserID)
+	assert.Equal(t, "second", listed[1].UserID)
+
+	listed, err = collaborators.ListByRepo(context.Background(), primitive.NewObjectID())
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+}
+
+func testCollaboratorListRepoIDsByUser(t *testing.T, collaborators repository.CollaboratorRepository) {
+	first, second := primitive.NewObjectID(), primitive.NewObjectID()
+	mustAdd(t, collaborators, NewCollaborator(first, "user", model.CollaboratorRead))
+	mustAdd(t, collaborators, NewCollaborator(second, "user", model.CollaboratorMaintain))
+	mustAdd(t, collaborators, NewCollaborator(primitive.NewObjectID(), "someone", model.CollaboratorRead))
+
+	ids, err := collaborators.ListRepoIDsByUser(context.Background(), "user")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []primitive.ObjectID{first, second}, ids)
+}
+
+func testCollaboratorUpdateRole(t *testing.T, collaborators repository.CollaboratorRepository) {
+	added := mustAdd(t, collaborators, NewCollaborator(primitive.NewObjectID(), "user", model.CollaboratorRead))
+	updatedAt := added.UpdatedAt.Add(time.Minute)
+
+	updated, err := collaborators.UpdateRole(context.Background(), added.RepoID, "user", model.CollaboratorMaintain, updatedAt)
+	require.NoError(t, err)
+	assert.Equal(t, model.CollaboratorMaintain, updated.Role)
+	assert.True(t, updatedAt.Equal(updated.UpdatedAt))
+
+	found, err := collaborators.Find(context.Background(), added.RepoID, "user")
+	require.NoError(t, err)
+	assert.Equal(t, model.CollaboratorMaintain, found.Role)
+
+	_, err = collaborators.UpdateRole(context.Background(), added.RepoID, "someone", model.CollaboratorAdmin, updatedAt)
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testCollaboratorRemove(t *testing.T, collaborators repository.CollaboratorRepository) {
+	added := mustAdd(t, collaborators, NewCollaborator(primitive.NewObjectID(), "user", model.CollaboratorRead))
+
+	require.NoError(t, collaborators.Remove(context.Background(), added.RepoID, "user"))
+
+	_, err := collaborators.Find(context.Background(), added.RepoID, "user")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	err = collaborators.Remove(context.Background(), added.RepoID, "user")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testCollaboratorRemoveByRepo(t *testing.T, collaborators repository.CollaboratorRepository) {
+	repoID, otherID := primitive.NewObjectID(), primitive.NewObjectID()
+	mustAdd(t, collaborators, NewCollaborator(repoID, "first", model.CollaboratorRead))
+	mustAdd(t, collaborators, NewCollaborator(repoID, "second", model.CollaboratorRead))
+	mustAdd(t, collaborators, NewCollaborator(otherID, "first", model.CollaboratorRead))
+
+	require.NoError(t, collaborators.RemoveByRepo(context.Background(), repoID))
+
+	listed, err := collaborators.ListByRepo(context.Background(), repoID)
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+
+	listed, err = collaborators.ListByRepo(context.Background(), otherID)
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+}
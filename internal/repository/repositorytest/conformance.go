@@ -0,0 +1,639 @@
+// Package repositorytest holds the behaviour every repository.RepoRepository
+// backend has to share with MongoRepoRepository.
+package repositorytest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Factory returns an empty backend; it is called once per conformance case
+type Factory func(t *testing.T) repository.RepoRepository
+
+func RunConformance(t *testing.T, factory Factory) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, repo repository.RepoRepository)
+	}{
+		{"CreateAndFindById", testCreateAndFindById},
+		{"FindById_InvalidID", testFindByIdInvalidID},
+		{"FindById_Missing", testFindByIdMissing},
+		{"FindByName", testFindByName},
+		{"FindByName_Missing", testFindByNameMissing},
+		{"Create_DuplicateID", testCreateDuplicateID},
+		{"FindByOwnerAndName", testFindByOwnerAndName},
+		{"FindByOwnerAndSkeleton", testFindByOwnerAndSkeleton},
+		{"Create_DuplicateName", testCreateDuplicateName},
+		{"Create_SameNameOtherOwner", testCreateSameNameOtherOwner},
+		{"UpdateOne_NameTaken", testUpdateOneNameTaken},
+		{"UpdateOne", testUpdateOne},
+		{"UpdateOne_Missing", testUpdateOneMissing},
+		{"DeleteOne", testDeleteOne},
+		{"DeleteOne_Missing", testDeleteOneMissing},
+		{"UpdateOne_StaleVersion", testUpdateOneStaleVersion},
+		{"UpdateOne_Concurrent", testUpdateOneConcurrent},
+		{"PatchOne", testPatchOne},
+		{"PatchOne_StaleVersion", testPatchOneStaleVersion},
+		{"PatchOne_NameTaken", testPatchOneNameTaken},
+		{"PatchOne_Missing", testPatchOneMissing},
+		{"List_Filters", testListFilters},
+		{"List_Sort", testListSort},
+		{"List_Pagination", testListPagination},
+		{"List_PaginationWithConcurrentInserts", testListPaginationWithConcurrentInserts},
+		{"List_CursorMismatch", testListCursorMismatch},
+		{"List_Access", testListAccess},
+		{"PatchOne_Visibility", testPatchOneVisibility},
+		{"Trash", testTrash},
+		{"Trash_ReservesName", testTrashReservesName},
+		{"Trash_Missing", testTrashMissing},
+		{"Restore", testRestore},
+		{"Restore_NotTrashed", testRestoreNotTrashed},
+		{"ListTrashed", testListTrashed},
+		{"DeleteOne_Trashed", testDeleteOneTrashed},
+		{"PatchOne_Archive", testPatchOneArchive},
+		{"List_Archived", testListArchived},
+		{"AddForks", testAddForks},
+		{"SetForks", testSetForks},
+		{"Count", testCount},
+		{"SetForkLinks", testSetForkLinks},
+		{"List_Forks", testListForks},
+		{"Create_FromTemplate", testCreateFromTemplate},
+		{"PatchOne_TemplateFields", testPatchOneTemplateFields},
+		{"AddStarsAndWatchers", testAddStarsAndWatchers},
+		{"SetStarsAndWatchers", testSetStarsAndWatchers},
+		{"List_SortByCounts", testListSortByCounts},
+		{"List_IDs", testListIDs},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			c.run(t, factory(t))
+		})
+	}
+}
+
+// NewRepo builds a fully populated repo; timestamps are truncated to what every
+// backend can store
+func NewRepo(name string) *model.PrivateRepoModel {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	return &model.PrivateRepoModel{
+		ID:          primitive.NewObjectID(),
+		Name:        name,
+		OwnerID:     primitive.NewObjectID().Hex(),
+		Description: "description of " + name,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Version:     1,
+		Skeleton:    name,
+	}
+}
+
+func mustCreate(t *testing.T, repo repository.RepoRepository, toCreate *model.PrivateRepoModel) *model.PrivateRepoModel {
+	created, err := repo.Create(context.Background(), toCreate)
+	require.NoError(t, err)
+
+	return created
+}
+
+func assertSameRepo(t *testing.T, expected, actual *model.PrivateRepoModel) {
+	assert.Equal(t, expected.ID, actual.ID)
+	assert.Equal(t, expected.Name, actual.Name)
+	assert.Equal(t, expected.OwnerID, actual.OwnerID)
+	assert.Equal(t, expected.Description, actual.Description)
+	assert.Equal(t, expected.Skeleton, actual.Skeleton)
+	assert.True(t, expected.CreatedAt.Equal(actual.CreatedAt), "created_at %s != %s", expected.CreatedAt, actual.CreatedAt)
+	assert.True(t, expected.UpdatedAt.Equal(actual.UpdatedAt), "updated_at %s != %s", expected.UpdatedAt, actual.UpdatedAt)
+}
+
+func testCreateAndFindById(t *testing.T, repo repository.RepoRepository) {
+	expected := mustCreate(t, repo, NewRepo("conformance"))
+
+	found, err := repo.FindById(context.Background(), expected.ID.Hex())
+
+	require.NoError(t, err)
+	assertSameRepo(t, expected, found)
+}
+
+func testFindByIdInvalidID(t *testing.T, repo repository.RepoRepository) {
+	for _, id := range []string{"", "invalid", "zzzzzzzzzzzzzzzzzzzzzzzz", primitive.NewObjectID().Hex() + "00"} {
+		_, err := repo.FindById(context.Background(), id)
+
+		assert.ErrorIs(t, err, repoerr.ErrInvalidID, "id %q", id)
+	}
+}
+
+func testFindByIdMissing(t *testing.T, repo repository.RepoRepository) {
+	mustCreate(t, repo, NewRepo("conformance"))
+
+	_, err := repo.FindById(context.Background(), primitive.NewObjectID().Hex())
+
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testFindByName(t *testing.T, repo repository.RepoRepository) {
+	mustCreate(t, repo, NewRepo("other"))
+	expected := mustCreate(t, repo, NewRepo("conformance"))
+
+	found, err := repo.FindByName(context.Background(), "conformance")
+
+	require.NoError(t, err)
+	assertSameRepo(t, expected, found)
+}
+
+func testFindByNameMissing(t *testing.T, repo repository.RepoRepository) {
+	mustCreate(t, repo, NewRepo("conformance"))
+
+	_, err := repo.FindByName(context.Background(), "missing")
+
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testCreateDuplicateID(t *testing.T, repo repository.RepoRepository) {
+	first := mustCreate(t, repo, NewRepo("conformance"))
+
+	duplicate := NewRepo("another")
+	duplicate.ID = first.ID
+	_, err := repo.Create(context.Background(), duplicate)
+
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+
+	found, err := repo.FindById(context.Background(), first.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "conformance", found.Name)
+}
+
+func testFindByOwnerAndName(t *testing.T, repo repository.RepoRepository) {
+	expected := mustCreate(t, repo, NewRepo("conformance"))
+	mustCreate(t, repo, NewRepo("conformance"))
+
+	found, err := repo.FindByOwnerAndName(context.Background(), expected.OwnerID, "conformance")
+	require.NoError(t, err)
+	assertSameRepo(t, expected, found)
+
+	_, err = repo.FindByOwnerAndName(context.Background(), expected.OwnerID, "missing")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = repo.FindByOwnerAndName(context.Background(), primitive.NewObjectID().Hex(), "conformance")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testFindByOwnerAndSkeleton(t *testing.T, repo repository.RepoRepository) {
+	expected := NewRepo("conformance")
+	expected.Skeleton = "skeleton"
+	mustCreate(t, repo, expected)
+
+	found, err := repo.FindByOwnerAndSkeleton(context.Background(), expected.OwnerID, "skeleton")
+	require.NoError(t, err)
+	assertSameRepo(t, expected, found)
+
+	_, err = repo.FindByOwnerAndSkeleton(context.Background(), primitive.NewObjectID().Hex(), "skeleton")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	// Renaming moves the repo to its new skeleton
+	name := "renamed"
+	_, err = repo.PatchOne(context.Background(), expected.ID.Hex(), &model.RepoPatch{Name: &name, Skeleton: "renamed"})
+	require.NoError(t, err)
+
+	_, err = repo.FindByOwnerAndSkeleton(context.Background(), expected.OwnerID, "skeleton")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	found, err = repo.FindByOwnerAndSkeleton(context.Background(), expected.OwnerID, "renamed")
+	require.NoError(t, err)
+	assert.Equal(t, expected.ID, found.ID)
+}
+
+func testCreateDuplicateName(t *testing.T, repo repository.RepoRepository) {
+	first := mustCreate(t, repo, NewRepo("conformance"))
+
+	duplicate := NewRepo("conformance")
+	duplicate.OwnerID = first.OwnerID
+	_, err := repo.Create(context.Background(), duplicate)
+
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+
+	_, err = repo.FindById(context.Background(), duplicate.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testCreateSameNameOtherOwner(t *testing.T, repo repository.RepoRepository) {
+	first := mustCreate(t, repo, NewRepo("conformance"))
+	second := mustCreate(t, repo, NewRepo("conformance"))
+
+	found, err := repo.FindByName(context.Background(), "conformance")
+
+	require.NoError(t, err)
+	assert.Contains(t, []primitive.ObjectID{first.ID, second.ID}, found.ID)
+}
+
+func testUpdateOneNameTaken(t *testing.T, repo repository.RepoRepository) {
+	taken := mustCreate(t, repo, NewRepo("taken"))
+
+	toRename := NewRepo("conformance")
+	toRename.OwnerID = taken.OwnerID
+	mustCreate(t, repo, toRename)
+
+	renamed := *toRename
+	renamed.Name = "taken"
+	_, err := repo.UpdateOne(context.Background(), &renamed)
+
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+
+	found, err := repo.FindById(context.Background(), toRename.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "conformance", found.Name)
+}
+
+func testUpdateOne(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+
+	changed := *created
+	changed.Name = "renamed"
+	changed.Description = "changed"
+	changed.UpdatedAt = created.UpdatedAt.Add(time.Minute)
+
+	updated, err := repo.UpdateOne(context.Background(), &changed)
+	require.NoError(t, err)
+	assert.Equal(t, created.Version+1, updated.Version)
+
+	found, err := repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assertSameRepo(t, &changed, found)
+	assert.Equal(t, created.Version+1, found.Version)
+
+	_, err = repo.FindByName(context.Background(), "conformance")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testUpdateOneMissing(t *testing.T, repo repository.RepoRepository) {
+	_, err := repo.UpdateOne(context.Background(), NewRepo("conformance"))
+
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testDeleteOne(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+	kept := mustCreate(t, repo, NewRepo("kept"))
+
+	require.NoError(t, repo.DeleteOne(context.Background(), created))
+
+	_, err := repo.FindById(context.Background(), created.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = repo.FindById(context.Background(), kept.ID.Hex())
+	assert.NoError(t, err)
+}
+
+func testDeleteOneMissing(t *testing.T, repo repository.RepoRepository) {
+	err := repo.DeleteOne(context.Background(), NewRepo("conformance"))
+
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testUpdateOneStaleVersion(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+
+	first := *created
+	first.Description = "first"
+	_, err := repo.UpdateOne(context.Background(), &first)
+	require.NoError(t, err)
+
+	stale := *created
+	stale.Description = "stale"
+	_, err = repo.UpdateOne(context.Background(), &stale)
+
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+	assert.ErrorIs(t, err, repoerr.ErrVersionMismatch)
+
+	found, err := repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "first", found.Description)
+}
+
+// Writers racing from the same version: exactly one wins, the others get a
+// version mismatch and the stored repo is the winner's
+func testUpdateOneConcurrent(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+
+	const writers = 20
+	results := make([]error, writers)
+
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			changed := *created
+			changed.Description = fmt.Sprintf("writer %d", i)
+
+			_, results[i] = repo.UpdateOne(context.Background(), &changed)
+		}(i)
+	}
+	wg.Wait()
+
+	winner := -1
+	for i, err := range results {
+		if err == nil {
+			assert.Equal(t, -1, winner, "more than one writer won")
+			winner = i
+			continue
+		}
+
+		assert.ErrorIs(t, err, repoerr.ErrVersionMismatch)
+	}
+	require.NotEqual(t, -1, winner, "no writer won")
+
+	found, err := repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("writer %d", winner), found.Description)
+	assert.Equal(t, created.Version+1, found.Version)
+}
+
+// Fields left out of a patch, including the immutable ones, keep their values
+func testPatchOne(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+
+	description := "patched"
+	updatedAt := created.UpdatedAt.Add(time.Minute)
+	patched, err := repo.PatchOne(context.Background(), created.ID.Hex(), &model.RepoPatch{
+		Description: &description,
+		UpdatedAt:   updatedAt,
+	})
+	require.NoError(t, err)
+
+	expected := *created
+	expected.Description = description
+	expected.UpdatedAt = updatedAt
+	assertSameRepo(t, &expected, patched)
+	assert.Equal(t, created.Version+1, patched.Version)
+
+	found, err := repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assertSameRepo(t, &expected, found)
+	assert.Equal(t, created.Version+1, found.Version)
+}
+
+func testPatchOneStaleVersion(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+
+	name := "renamed"
+	_, err := repo.PatchOne(context.Background(), created.ID.Hex(), &model.RepoPatch{Name: &name, Version: created.Version + 1})
+
+	assert.ErrorIs(t, err, repoerr.ErrVersionMismatch)
+
+	found, err := repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, "conformance", found.Name)
+}
+
+func testPatchOneNameTaken(t *testing.T, repo repository.RepoRepository) {
+	taken := mustCreate(t, repo, NewRepo("taken"))
+
+	toRename := NewRepo("conformance")
+	toRename.OwnerID = taken.OwnerID
+	mustCreate(t, repo, toRename)
+
+	name := "taken"
+	_, err := repo.PatchOne(context.Background(), toRename.ID.Hex(), &model.RepoPatch{Name: &name})
+
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+}
+
+func testPatchOneMissing(t *testing.T, repo repository.RepoRepository) {
+	name := "renamed"
+	_, err := repo.PatchOne(context.Background(), primitive.NewObjectID().Hex(), &model.RepoPatch{Name: &name})
+
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testListAccess(t *testing.T, repo repository.RepoRepository) {
+	member := primitive.NewObjectID().Hex()
+	org := primitive.NewObjectID().Hex()
+	created := map[string]*model.PrivateRepoModel{}
+
+	for _, tc := range []struct {
+		name       string
+		ownerID    string
+		ownerType  string
+		visibility string
+	}{
+		{"legacy", "", "", ""},
+		{"public", "", "", model.VisibilityPublic},
+		{"internal", "", "", model.VisibilityInternal},
+		{"private", "", "", model.VisibilityPrivate},
+		{"own-private", member, "", model.VisibilityPrivate},
+		{"org-private", org, model.OwnerOrganization, model.VisibilityPrivate},
+	} {
+		toCreate := NewRepo(tc.name)
+		toCreate.Visibility = tc.visibility
+		toCreate.OwnerType = tc.ownerType
+		if tc.ownerID != "" {
+			toCreate.OwnerID = tc.ownerID
+		}
+		created[tc.name] = mustCreate(t, repo, toCreate)
+	}
+
+	page, err := repo.List(context.Background(), &model.RepoListQuery{
+		Access: &model.RepoAccess{Visibilities: []string{model.VisibilityPublic}},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"legacy", "public"}, names(page.Repos))
+
+	page, err = repo.List(context.Background(), &model.RepoListQuery{
+		Access: &model.RepoAccess{Visibilities: []string{model.VisibilityPublic, model.VisibilityInternal}, MemberID: member},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"legacy", "public", "internal", "own-private"}, names(page.Repos))
+
+	page, err = repo.List(context.Background(), &model.RepoListQuery{
+		Access: &model.RepoAccess{Visibilities: []string{model.VisibilityPublic}, RepoIDs: []primitive.ObjectID{created["private"].ID}},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"legacy", "public", "private"}, names(page.Repos))
+
+	page, err = repo.List(context.Background(), &model.RepoListQuery{
+		Access: &model.RepoAccess{Visibilities: []string{model.VisibilityPublic}, OrgIDs: []string{org}},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"legacy", "public", "org-private"}, names(page.Repos))
+
+	page, err = repo.List(context.Background(), &model.RepoListQuery{})
+	require.NoError(t, err)
+	assert.Len(t, page.Repos, 6)
+}
+
+func testPatchOneVisibility(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+
+	visibility := model.VisibilityPrivate
+	_, err := repo.PatchOne(context.Background(), created.ID.Hex(), &model.RepoPatch{Visibility: &visibility})
+	require.NoError(t, err)
+
+	found, err := repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, model.VisibilityPrivate, found.Visibility)
+	assert.Equal(t, created.Name, found.Name)
+}
+
+func names(repos []*model.PrivateRepoModel) []string {
+	result := make([]string, 0, len(repos))
+	for _, repo := range repos {
+		result = append(result, repo.Name)
+	}
+
+	return result
+}
+
+func testListFilters(t *testing.T, repo repository.RepoRepository) {
+	ownerID := primitive.NewObjectID().Hex()
+	base := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, name := range []string{"api", "api-gateway", "web", "apix"} {
+		toCreate := NewRepo(name)
+		toCreate.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+		toCreate.UpdatedAt = toCreate.CreatedAt.Add(time.Minute)
+		if name != "apix" {
+			toCreate.OwnerID = ownerID
+		}
+		mustCreate(t, repo, toCreate)
+	}
+
+	page, err := repo.List(context.Background(), &model.RepoListQuery{OwnerID: ownerID, NamePrefix: "api"})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"api", "api-gateway"}, names(page.Repos))
+	assert.Empty(t, page.NextCursor)
+
+	page, err = repo.List(context.Background(), &model.RepoListQuery{
+		CreatedAfter:  base.Add(time.Hour),
+		CreatedBefore: base.Add(3 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"api-gateway", "web"}, names(page.Repos))
+
+	page, err = repo.List(context.Background(), &model.RepoListQuery{UpdatedAfter: base.Add(3 * time.Hour)})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"apix"}, names(page.Repos))
+
+	page, err = repo.List(context.Background(), &model.RepoListQuery{NamePrefix: "a.i"})
+	require.NoError(t, err)
+	assert.Empty(t, page.Repos)
+}
+
+func testListSort(t *testing.T, repo repository.RepoRepository) {
+	base := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for i, name := range []string{"charlie", "alpha", "bravo"} {
+		toCreate := NewRepo(name)
+		toCreate.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+		toCreate.UpdatedAt = base.Add(time.Duration(-i) * time.Hour)
+		mustCreate(t, repo, toCreate)
+	}
+
+	for _, c := range []struct {
+		sortBy     string
+		descending bool
+		expected   []string
+	}{
+		{model.SortByCreatedAt, false, []string{"charlie", "alpha", "bravo"}},
+		{model.SortByCreatedAt, true, []string{"bravo", "alpha", "charlie"}},
+		{model.SortByUpdatedAt, false, []string{"bravo", "alpha", "charlie"}},
+		{model.SortByName, false, []string{"alpha", "bravo", "charlie"}},
+		{model.SortByName, true, []string{"charlie", "bravo", "alpha"}},
+	} {
+		page, err := repo.List(context.Background(), &model.RepoListQuery{SortBy: c.sortBy, Descending: c.descending})
+
+		require.NoError(t, err)
+		assert.Equal(t, c.expected, names(page.Repos), "sort %s descending=%v", c.sortBy, c.descending)
+	}
+}
+
+func listAll(t *testing.T, repo repository.RepoRepository, query model.RepoListQuery) []string {
+	result := []string{}
+	for {
+		page, err := repo.List(context.Background(), &query)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page.Repos), query.Limit)
+
+		result = append(result, names(page.Repos)...)
+		if page.NextCursor == "" {
+			return result
+		}
+		query.Cursor = page.NextCursor
+	}
+}
+
+func testListPagination(t *testing.T, repo repository.RepoRepository) {
+	createdAt := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	expected := []string{}
+
+	// Identical sort values force the _id tie breaker
+	for i := 0; i < 7; i++ {
+		toCreate := NewRepo(fmt.Sprintf("repo-%d", i))
+		toCreate.CreatedAt = createdAt
+		mustCreate(t, repo, toCreate)
+		expected = append(expected, toCreate.Name)
+	}
+
+	assert.Equal(t, expected, listAll(t, repo, model.RepoListQuery{SortBy: model.SortByCreatedAt, Limit: 3}))
+
+	reversed := make([]string, 0, len(expected))
+	for i := len(expected) - 1; i >= 0; i-- {
+		reversed = append(reversed, expected[i])
+	}
+	assert.Equal(t, reversed, listAll(t, repo, model.RepoListQuery{SortBy: model.SortByCreatedAt, Descending: true, Limit: 3}))
+}
+
+// Repos created while a client pages through the list must not cause existing repos
+// to be skipped or returned twice
+func testListPaginationWithConcurrentInserts(t *testing.T, repo repository.RepoRepository) {
+	for _, name := range []string{"b", "d", "f", "h"} {
+		mustCreate(t, repo, NewRepo(name))
+	}
+
+	query := &model.RepoListQuery{SortBy: model.SortByName, Limit: 2}
+	first, err := repo.List(context.Background(), query)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "d"}, names(first.Repos))
+
+	var wg sync.WaitGroup
+	for _, name := range []string{"a", "c", "e", "g"} {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			mustCreate(t, repo, NewRepo(name))
+		}(name)
+	}
+	wg.Wait()
+
+	query.Cursor = first.NextCursor
+	rest := listAll(t, repo, *query)
+
+	assert.Equal(t, []string{"e", "f", "g", "h"}, rest)
+}
+
+func testListCursorMismatch(t *testing.T, repo repository.RepoRepository) {
+	for _, name := range []string{"a", "b", "c"} {
+		mustCreate(t, repo, NewRepo(name))
+	}
+
+	page, err := repo.List(context.Background(), &model.RepoListQuery{SortBy: model.SortByName, Limit: 1})
+	require.NoError(t, err)
+
+	_, err = repo.List(context.Background(), &model.RepoListQuery{SortBy: model.SortByCreatedAt, Limit: 1, Cursor: page.NextCursor})
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+
+	_, err = repo.List(context.Background(), &model.RepoListQuery{Limit: 1, Cursor: "garbage"})
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+}
@@ -0,0 +1,120 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func mustFork(t *testing.T, repo repository.RepoRepository, name string, ownerID string, parent *model.PrivateRepoModel) *model.PrivateRepoModel {
+	toCreate := NewRepo(name)
+	toCreate.OwnerID = ownerID
+	rootID := parent.NetworkID()
+	toCreate.ParentID = &parent.ID
+	toCreate.RootID = &rootID
+
+	return mustCreate(t, repo, toCreate)
+}
+
+// Fork counts change without a version bump and survive full updates
+func testAddForks(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+
+	require.NoError(t, repo.AddForks(context.Background(), created.ID.Hex(), 2))
+	require.NoError(t, repo.AddForks(context.Background(), created.ID.Hex(), -1))
+
+	found, err := repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), found.ForksCount)
+	assert.Equal(t, created.Version, found.Version)
+
+	found.Description = "Updated"
+	_, err = repo.UpdateOne(context.Background(), found)
+	require.NoError(t, err)
+
+	found, err = repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), found.ForksCount)
+
+	assert.ErrorIs(t, repo.AddForks(context.Background(), primitive.NewObjectID().Hex(), 1), repoerr.ErrNotFound)
+}
+
+func testSetForks(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+	require.NoError(t, repo.AddForks(context.Background(), created.ID.Hex(), 5))
+
+	require.NoError(t, repo.SetForks(context.Background(), created.ID.Hex(), 2))
+
+	found, err := repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), found.ForksCount)
+	assert.Equal(t, created.Version, found.Version)
+
+	assert.ErrorIs(t, repo.SetForks(context.Background(), primitive.NewObjectID().Hex(), 1), repoerr.ErrNotFound)
+}
+
+func testSetForkLinks(t *testing.T, repo repository.RepoRepository) {
+	root := mustCreate(t, repo, NewRepo("root"))
+	fork := mustFork(t, repo, "fork", primitive.NewObjectID().Hex(), root)
+
+	found, err := repo.FindById(context.Background(), fork.ID.Hex())
+	require.NoError(t, err)
+	require.True(t, found.IsFork())
+	assert.Equal(t, root.ID, *found.ParentID)
+	assert.Equal(t, root.ID, found.NetworkID())
+
+	require.NoError(t, repo.SetForkLinks(context.Background(), fork.ID.Hex(), nil, nil))
+
+	found, err = repo.FindById(context.Background(), fork.ID.Hex())
+	require.NoError(t, err)
+	assert.False(t, found.IsFork())
+	assert.Equal(t, fork.ID, found.NetworkID())
+	assert.Equal(t, fork.Version+1, found.Version)
+
+	assert.ErrorIs(t, repo.SetForkLinks(context.Background(), primitive.NewObjectID().Hex(), nil, nil), repoerr.ErrNotFound)
+}
+
+func testListForks(t *testing.T, repo repository.RepoRepository) {
+	root := mustCreate(t, repo, NewRepo("root"))
+	fork := mustFork(t, repo, "fork", primitive.NewObjectID().Hex(), root)
+	mustFork(t, repo, "nested", primitive.NewObjectID().Hex(), fork)
+	mustCreate(t, repo, NewRepo("unrelated"))
+
+	forks, err := repo.List(context.Background(), &model.RepoListQuery{ParentID: root.ID, SortBy: model.SortByName})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fork"}, names(forks.Repos))
+
+	network, err := repo.List(context.Background(), &model.RepoListQuery{NetworkID: root.ID, SortBy: model.SortByName})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fork", "nested", "root"}, names(network.Repos))
+
+	// Only the root's ID names the network
+	network, err = repo.List(context.Background(), &model.RepoListQuery{NetworkID: fork.ID})
+	require.NoError(t, err)
+	assert.Empty(t, network.Repos)
+}
+
+// Count matches List over all pages, repos in the trash are left out
+func testCount(t *testing.T, repo repository.RepoRepository) {
+	root := mustCreate(t, repo, NewRepo("root"))
+	mustFork(t, repo, "first", primitive.NewObjectID().Hex(), root)
+	mustFork(t, repo, "second", primitive.NewObjectID().Hex(), root)
+	trashed := mustFork(t, repo, "trashed", primitive.NewObjectID().Hex(), root)
+	_, err := repo.Trash(context.Background(), trashed.ID.Hex(), time.Now())
+	require.NoError(t, err)
+
+	count, err := repo.Count(context.Background(), &model.RepoListQuery{ParentID: root.ID, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = repo.Count(context.Background(), &model.RepoListQuery{ParentID: primitive.NewObjectID()})
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
@@ -0,0 +1,160 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// OrganizationFactory returns an empty backend; it is called once per conformance case
+type OrganizationFactory func(t *testing.T) repository.OrganizationRepository
+
+// RunOrganizationConformance holds the behaviour every OrganizationRepository
+// backend has to share with MongoOrganizationRepository
+func RunOrganizationConformance(t *testing.T, factory OrganizationFactory) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, orgs repository.OrganizationRepository)
+	}{
+		{"CreateAndFind", testOrganizationCreateAndFind},
+		{"Create_DuplicateLogin", testOrganizationCreateDuplicateLogin},
+		{"Find_Missing", testOrganizationFindMissing},
+		{"FindMember", testOrganizationFindMember},
+		{"ListIDsByMember", testOrganizationListIDsByMember},
+		{"SetMember", testOrganizationSetMember},
+		{"SetMember_Missing", testOrganizationSetMemberMissing},
+		{"RemoveMember", testOrganizationRemoveMember},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			c.run(t, factory(t))
+		})
+	}
+}
+
+// NewOrganization builds an organization owned by ownerID; timestamps are truncated
+// to what every backend can store
+func NewOrganization(login string, ownerID string) *model.Organization {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	return &model.Organization{
+		Login:     login,
+		Name:      "Organization " + login,
+		Members:   []model.OrgMember{{UserID: ownerID, Role: model.OrgRoleOwner}},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func mustCreateOrganization(t *testing.T, orgs repository.OrganizationRepository, org *model.Organization) *model.Organization {
+	created, err := orgs.Create(context.Background(), org)
+	require.NoError(t, err)
+
+	return created
+}
+
+func testOrganizationCreateAndFind(t *testing.T, orgs repository.OrganizationRepository) {
+	created := mustCreateOrganization(t, orgs, NewOrganization("acme", "owner"))
+	assert.False(t, created.ID.IsZero())
+
+	found, err := orgs.FindByID(context.Background(), created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "acme", found.Login)
+	assert.Equal(t, created.Members, found.Members)
+	assert.True(t, created.CreatedAt.Equal(found.CreatedAt))
+
+	found, err = orgs.FindByLogin(context.Background(), "acme")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, found.ID)
+}
+
+func testOrganizationCreateDuplicateLogin(t *testing.T, orgs repository.OrganizationRepository) {
+	mustCreateOrganization(t, orgs, NewOrganization("acme", "owner"))
+
+	_, err := orgs.Create(context.Background(), NewOrganization("acme", "someone"))
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+}
+
+func testOrganizationFindMissing(t *testing.T, orgs repository.OrganizationRepository) {
+	_, err := orgs.FindByID(context.Background(), primitive.NewObjectID())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = orgs.FindByLogin(context.Background(), "acme")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testOrganizationFindMember(t *testing.T, orgs repository.OrganizationRepository) {
+	created := mustCreateOrganization(t, orgs, NewOrganization("acme", "owner"))
+
+	member, err := orgs.FindMember(context.Background(), created.ID, "owner")
+	require.NoError(t, err)
+	assert.Equal(t, model.OrgRoleOwner, member.Role)
+
+	_, err = orgs.FindMember(context.Background(), created.ID, "someone")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = orgs.FindMember(context.Background(), primitive.NewObjectID(), "owner")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testOrganizationListIDsByMember(t *testing.T, orgs repository.OrganizationRepository) {
+	first := mustCreateOrganization(t, orgs, NewOrganization("first", "user"))
+	second := mustCreateOrganization(t, orgs, NewOrganization("second", "owner"))
+	mustCreateOrganization(t, orgs, NewOrganization("third", "owner"))
+
+	_, err := orgs.SetMember(context.Background(), second.ID, model.OrgMember{UserID: "user", Role: model.OrgRoleMember}, time.Now())
+	require.NoError(t, err)
+
+	ids, err := orgs.ListIDsByMember(context.Background(), "user")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []primitive.ObjectID{first.ID, second.ID}, ids)
+}
+
+func testOrganizationSetMember(t *testing.T, orgs repository.OrganizationRepository) {
+	created := mustCreateOrganization(t, orgs, NewOrganization("acme", "owner"))
+	updatedAt := created.UpdatedAt.Add(time.Minute)
+
+	updated, err := orgs.SetMember(context.Background(), created.ID, model.OrgMember{UserID: "user", Role: model.OrgRoleMember}, updatedAt)
+	require.NoError(t, err)
+	assert.Len(t, updated.Members, 2)
+	assert.True(t, updatedAt.Equal(updated.UpdatedAt))
+
+	// Setting an existing member changes the role in place
+	updated, err = orgs.SetMember(context.Background(), created.ID, model.OrgMember{UserID: "user", Role: model.OrgRoleOwner}, updatedAt)
+	require.NoError(t, err)
+	assert.Len(t, updated.Members, 2)
+
+	member, err := orgs.FindMember(context.Background(), created.ID, "user")
+	require.NoError(t, err)
+	assert.Equal(t, model.OrgRoleOwner, member.Role)
+}
+
+func testOrganizationSetMemberMissing(t *testing.T, orgs repository.OrganizationRepository) {
+	_, err := orgs.SetMember(context.Background(), primitive.NewObjectID(), model.OrgMember{UserID: "user", Role: model.OrgRoleMember}, time.Now())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testOrganizationRemoveMember(t *testing.T, orgs repository.OrganizationRepository) {
+	created := mustCreateOrganization(t, orgs, NewOrganization("acme", "owner"))
+	_, err := orgs.SetMember(context.Background(), created.ID, model.OrgMember{UserID: "user", Role: model.OrgRoleMember}, time.Now())
+	require.NoError(t, err)
+
+	updated, err := orgs.RemoveMember(context.Background(), created.ID, "user", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, []model.OrgMember{{UserID: "owner", Role: model.OrgRoleOwner}}, updated.Members)
+
+	_, err = orgs.RemoveMember(context.Background(), created.ID, "user", time.Now())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = orgs.RemoveMember(context.Background(), primitive.NewObjectID(), "owner", time.Now())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
@@ -0,0 +1,108 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RedirectFactory returns an empty backend; it is called once per conformance case
+type RedirectFactory func(t *testing.T) repository.RedirectRepository
+
+// RunRedirectConformance holds the behaviour every RedirectRepository backend has
+// to share with MongoRedirectRepository
+func RunRedirectConformance(t *testing.T, factory RedirectFactory) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, redirects repository.RedirectRepository)
+	}{
+		{"SaveAndFind", testRedirectSaveAndFind},
+		{"Save_Replaces", testRedirectSaveReplaces},
+		{"Find_Missing", testRedirectFindMissing},
+		{"Remove", testRedirectRemove},
+		{"RemoveByRepo", testRedirectRemoveByRepo},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			c.run(t, factory(t))
+		})
+	}
+}
+
+// NewRedirect builds a redirect; the timestamp is truncated to what every backend
+// can store
+func NewRedirect(ownerID string, name string, repoID primitive.ObjectID) *model.Redirect {
+	return &model.Redirect{
+		OwnerID:   ownerID,
+		Name:      name,
+		RepoID:    repoID,
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+func testRedirectSaveAndFind(t *testing.T, redirects repository.RedirectRepository) {
+	saved := NewRedirect("owner", "old", primitive.NewObjectID())
+	require.NoError(t, redirects.Save(context.Background(), saved))
+
+	found, err := redirects.Find(context.Background(), "owner", "old")
+	require.NoError(t, err)
+	assert.Equal(t, saved.RepoID, found.RepoID)
+	assert.True(t, saved.CreatedAt.Equal(found.CreatedAt))
+
+	// Addresses are per owner
+	_, err = redirects.Find(context.Background(), "someone", "old")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testRedirectSaveReplaces(t *testing.T, redirects repository.RedirectRepository) {
+	require.NoError(t, redirects.Save(context.Background(), NewRedirect("owner", "old", primitive.NewObjectID())))
+
+	latest := NewRedirect("owner", "old", primitive.NewObjectID())
+	require.NoError(t, redirects.Save(context.Background(), latest))
+
+	found, err := redirects.Find(context.Background(), "owner", "old")
+	require.NoError(t, err)
+	assert.Equal(t, latest.RepoID, found.RepoID)
+}
+
+func testRedirectFindMissing(t *testing.T, redirects repository.RedirectRepository) {
+	_, err := redirects.Find(context.Background(), "owner", "missing")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testRedirectRemove(t *testing.T, redirects repository.RedirectRepository) {
+	require.NoError(t, redirects.Save(context.Background(), NewRedirect("owner", "old", primitive.NewObjectID())))
+
+	require.NoError(t, redirects.Remove(context.Background(), "owner", "old"))
+
+	_, err := redirects.Find(context.Background(), "owner", "old")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	// Removing a missing redirect is not an error
+	assert.NoError(t, redirects.Remove(context.Background(), "owner", "old"))
+}
+
+func testRedirectRemoveByRepo(t *testing.T, redirects repository.RedirectRepository) {
+	repoID := primitive.NewObjectID()
+	require.NoError(t, redirects.Save(context.Background(), NewRedirect("owner", "first", repoID)))
+	require.NoError(t, redirects.Save(context.Background(), NewRedirect("other", "second", repoID)))
+	require.NoError(t, redirects.Save(context.Background(), NewRedirect("owner", "kept", primitive.NewObjectID())))
+
+	require.NoError(t, redirects.RemoveByRepo(context.Background(), repoID))
+
+	_, err := redirects.Find(context.Background(), "owner", "first")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+	_, err = redirects.Find(context.Background(), "other", "second")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+	_, err = redirects.Find(context.Background(), "owner", "kept")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,87 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Star and watcher counts change without a version bump and survive full updates
+func testAddStarsAndWatchers(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+
+	require.NoError(t, repo.AddStars(context.Background(), created.ID.Hex(), 3))
+	require.NoError(t, repo.AddStars(context.Background(), created.ID.Hex(), -1))
+	require.NoError(t, repo.AddWatchers(context.Background(), created.ID.Hex(), 1))
+
+	found, err := repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), found.StarsCount)
+	assert.Equal(t, int64(1), found.WatchersCount)
+	assert.Equal(t, created.Version, found.Version)
+
+	stale := *found
+	stale.StarsCount, stale.WatchersCount = 0, 0
+	stale.Description = "Updated"
+	_, err = repo.UpdateOne(context.Background(), &stale)
+	require.NoError(t, err)
+
+	found, err = repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), found.StarsCount)
+	assert.Equal(t, int64(1), found.WatchersCount)
+
+	assert.ErrorIs(t, repo.AddStars(context.Background(), primitive.NewObjectID().Hex(), 1), repoerr.ErrNotFound)
+	assert.ErrorIs(t, repo.AddWatchers(context.Background(), "invalid", 1), repoerr.ErrInvalidID)
+}
+
+func testSetStarsAndWatchers(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+	require.NoError(t, repo.AddStars(context.Background(), created.ID.Hex(), 4))
+
+	require.NoError(t, repo.SetStars(context.Background(), created.ID.Hex(), 1))
+	require.NoError(t, repo.SetWatchers(context.Background(), created.ID.Hex(), 2))
+
+	found, err := repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), found.StarsCount)
+	assert.Equal(t, int64(2), found.WatchersCount)
+	assert.Equal(t, created.Version, found.Version)
+
+	assert.ErrorIs(t, repo.SetWatchers(context.Background(), primitive.NewObjectID().Hex(), 1), repoerr.ErrNotFound)
+}
+
+// Sorting by a count pages through every repo, ties broken by _id
+func testListSortByCounts(t *testing.T, repo repository.RepoRepository) {
+	for i, name := range []string{"none", "few", "many", "also-few"} {
+		created := mustCreate(t, repo, NewRepo(name))
+		stars := []int{0, 2, 5, 2}[i]
+		if stars > 0 {
+			require.NoError(t, repo.AddStars(context.Background(), created.ID.Hex(), stars))
+		}
+		require.NoError(t, repo.AddWatchers(context.Background(), created.ID.Hex(), 3-i))
+	}
+
+	assert.Equal(t, []string{"many", "also-few", "few", "none"},
+		listAll(t, repo, model.RepoListQuery{SortBy: model.SortByStars, Descending: true, Limit: 1}))
+	assert.Equal(t, []string{"none", "few", "also-few", "many"},
+		listAll(t, repo, model.RepoListQuery{SortBy: model.SortByStars, Limit: 3}))
+	assert.Equal(t, []string{"none", "few", "many", "also-few"},
+		listAll(t, repo, model.RepoListQuery{SortBy: model.SortByWatchers, Descending: true, Limit: 2}))
+}
+
+func testListIDs(t *testing.T, repo repository.RepoRepository) {
+	first := mustCreate(t, repo, NewRepo("first"))
+	mustCreate(t, repo, NewRepo("second"))
+	third := mustCreate(t, repo, NewRepo("third"))
+
+	page, err := repo.List(context.Background(), &model.RepoListQuery{IDs: []primitive.ObjectID{third.ID, first.ID}, SortBy: model.SortByName})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"first", "third"}, names(page.Repos))
+}
@@ -0,0 +1,201 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SubscriptionFactory returns an empty backend; it is called once per conformance case
+type SubscriptionFactory func(t *testing.T) repository.SubscriptionRepository
+
+// RunSubscriptionConformance holds the behaviour every SubscriptionRepository
+// backend has to share with MongoSubscriptionRepository
+func RunSubscriptionConformance(t *testing.T, factory SubscriptionFactory) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, subscriptions repository.SubscriptionRepository)
+	}{
+		{"Add", testSubscriptionAdd},
+		{"Remove", testSubscriptionRemove},
+		{"ListByRepo", testSubscriptionListByRepo},
+		{"ListByRepo_InvalidCursor", testSubscriptionListByRepoInvalidCursor},
+		{"ListByUser", testSubscriptionListByUser},
+		{"Count", testSubscriptionCount},
+		{"RemoveByRepo", testSubscriptionRemoveByRepo},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			c.run(t, factory(t))
+		})
+	}
+}
+
+// NewSubscription builds a subscription; the timestamp is truncated to what every
+// backend can store
+func NewSubscription(repoID primitive.ObjectID, userID string, kind string) *model.Subscription {
+	return &model.Subscription{
+		RepoID:    repoID,
+		UserID:    userID,
+		Kind:      kind,
+		CreatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+}
+
+func mustSubscribe(t *testing.T, subscriptions repository.SubscriptionRepository, subscription *model.Subscription) {
+	added, err := subscriptions.Add(context.Background(), subscription)
+	require.NoError(t, err)
+	require.True(t, added)
+}
+
+func subscribers(page *model.SubscriptionPage) []string {
+	result := make([]string, 0, len(page.Subscriptions))
+	for _, subscription := range page.Subscriptions {
+		result = append(result, subscription.UserID)
+	}
+
+	return result
+}
+
+func subscribedRepos(page *model.SubscriptionPage) []primitive.ObjectID {
+	result := make([]primitive.ObjectID, 0, len(page.Subscriptions))
+	for _, subscription := range page.Subscriptions {
+		result = append(result, subscription.RepoID)
+	}
+
+	return result
+}
+
+// Adding twice only adds once, starring and watching are independent
+func testSubscriptionAdd(t *testing.T, subscriptions repository.SubscriptionRepository) {
+	repoID := primitive.NewObjectID()
+	mustSubscribe(t, subscriptions, NewSubscription(repoID, "user", model.SubscriptionStar))
+
+	added, err := subscriptions.Add(context.Background(), NewSubscription(repoID, "user", model.SubscriptionStar))
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	mustSubscribe(t, subscriptions, NewSubscription(repoID, "user", model.SubscriptionWatch))
+
+	page, err := subscriptions.ListByRepo(context.Background(), repoID, model.SubscriptionStar, &model.SubscriptionQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user"}, subscribers(page))
+}
+
+func testSubscriptionRemove(t *testing.T, subscriptions repository.SubscriptionRepository) {
+	repoID := primitive.NewObjectID()
+	mustSubscribe(t, subscriptions, NewSubscription(repoID, "user", model.SubscriptionStar))
+	mustSubscribe(t, subscriptions, NewSubscription(repoID, "user", model.SubscriptionWatch))
+
+	removed, err := subscriptions.Remove(context.Background(), repoID, "user", model.SubscriptionStar)
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	removed, err = subscriptions.Remove(context.Background(), repoID, "user", model.SubscriptionStar)
+	require.NoError(t, err)
+	assert.False(t, removed)
+
+	page, err := subscriptions.ListByRepo(context.Background(), repoID, model.SubscriptionWatch, &model.SubscriptionQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user"}, subscribers(page))
+}
+
+// Pages run newest first and are stable under concurrent subscriptions
+func testSubscriptionListByRepo(t *testing.T, subscriptions repository.SubscriptionRepository) {
+	repoID := primitive.NewObjectID()
+	for _, userID := range []string{"a", "b", "c", "d", "e"} {
+		mustSubscribe(t, subscriptions, NewSubscription(repoID, userID, model.SubscriptionStar))
+	}
+	mustSubscribe(t, subscriptions, NewSubscription(primitive.NewObjectID(), "other", model.SubscriptionStar))
+
+	query := &model.SubscriptionQuery{Limit: 2}
+	first, err := subscriptions.ListByRepo(context.Background(), repoID, model.SubscriptionStar, query)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"e", "d"}, subscribers(first))
+	require.NotEmpty(t, first.NextCursor)
+
+	mustSubscribe(t, subscriptions, NewSubscription(repoID, "f", model.SubscriptionStar))
+
+	query.Cursor = first.NextCursor
+	second, err := subscriptions.ListByRepo(context.Background(), repoID, model.SubscriptionStar, query)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "b"}, subscribers(second))
+
+	query.Cursor = second.NextCursor
+	last, err := subscriptions.ListByRepo(context.Background(), repoID, model.SubscriptionStar, query)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, subscribers(last))
+	assert.Empty(t, last.NextCursor)
+}
+
+func testSubscriptionListByRepoInvalidCursor(t *testing.T, subscriptions repository.SubscriptionRepository) {
+	_, err := subscriptions.ListByRepo(context.Background(), primitive.NewObjectID(), model.SubscriptionStar, &model.SubscriptionQuery{Limit: 1, Cursor: "garbage"})
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+}
+
+// Pages of a user's subscriptions run newest first as well
+func testSubscriptionListByUser(t *testing.T, subscriptions repository.SubscriptionRepository) {
+	older, newer := primitive.NewObjectID(), primitive.NewObjectID()
+	mustSubscribe(t, subscriptions, NewSubscription(older, "user", model.SubscriptionStar))
+	mustSubscribe(t, subscriptions, NewSubscription(primitive.NewObjectID(), "user", model.SubscriptionWatch))
+	mustSubscribe(t, subscriptions, NewSubscription(primitive.NewObjectID(), "other", model.SubscriptionStar))
+	mustSubscribe(t, subscriptions, NewSubscription(newer, "user", model.SubscriptionStar))
+
+	query := &model.SubscriptionQuery{Limit: 1}
+	first, err := subscriptions.ListByUser(context.Background(), "user", model.SubscriptionStar, query)
+	require.NoError(t, err)
+	assert.Equal(t, []primitive.ObjectID{newer}, subscribedRepos(first))
+	require.NotEmpty(t, first.NextCursor)
+
+	query.Cursor = first.NextCursor
+	last, err := subscriptions.ListByUser(context.Background(), "user", model.SubscriptionStar, query)
+	require.NoError(t, err)
+	assert.Equal(t, []primitive.ObjectID{older}, subscribedRepos(last))
+	assert.Empty(t, last.NextCursor)
+
+	page, err := subscriptions.ListByUser(context.Background(), "nobody", model.SubscriptionStar, &model.SubscriptionQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, page.Subscriptions)
+}
+
+func testSubscriptionCount(t *testing.T, subscriptions repository.SubscriptionRepository) {
+	repoID := primitive.NewObjectID()
+	mustSubscribe(t, subscriptions, NewSubscription(repoID, "a", model.SubscriptionStar))
+	mustSubscribe(t, subscriptions, NewSubscription(repoID, "b", model.SubscriptionStar))
+	mustSubscribe(t, subscriptions, NewSubscription(repoID, "a", model.SubscriptionWatch))
+	mustSubscribe(t, subscriptions, NewSubscription(primitive.NewObjectID(), "a", model.SubscriptionStar))
+
+	count, err := subscriptions.Count(context.Background(), repoID, model.SubscriptionStar)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = subscriptions.Count(context.Background(), primitive.NewObjectID(), model.SubscriptionWatch)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func testSubscriptionRemoveByRepo(t *testing.T, subscriptions repository.SubscriptionRepository) {
+	repoID, otherID := primitive.NewObjectID(), primitive.NewObjectID()
+	mustSubscribe(t, subscriptions, NewSubscription(repoID, "a", model.SubscriptionStar))
+	mustSubscribe(t, subscriptions, NewSubscription(repoID, "b", model.SubscriptionWatch))
+	mustSubscribe(t, subscriptions, NewSubscription(otherID, "a", model.SubscriptionStar))
+
+	require.NoError(t, subscriptions.RemoveByRepo(context.Background(), repoID))
+
+	starred, err := subscriptions.ListByUser(context.Background(), "a", model.SubscriptionStar, &model.SubscriptionQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, []primitive.ObjectID{otherID}, subscribedRepos(starred))
+
+	page, err := subscriptions.ListByRepo(context.Background(), repoID, model.SubscriptionWatch, &model.SubscriptionQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, page.Subscriptions)
+}
@@ -0,0 +1,217 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TeamFactory returns an empty backend; it is called once per conformance case
+type TeamFactory func(t *testing.T) repository.TeamRepository
+
+// RunTeamConformance holds the behaviour every TeamRepository backend has to share
+// with MongoTeamRepository
+func RunTeamConformance(t *testing.T, factory TeamFactory) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, teams repository.TeamRepository)
+	}{
+		{"CreateAndFind", testTeamCreateAndFind},
+		{"Create_DuplicateSlug", testTeamCreateDuplicateSlug},
+		{"Find_Missing", testTeamFindMissing},
+		{"ListByOrg", testTeamListByOrg},
+		{"Members", testTeamMembers},
+		{"RemoveMember_Missing", testTeamRemoveMemberMissing},
+		{"RemoveOrgMember", testTeamRemoveOrgMember},
+		{"Repos", testTeamRepos},
+		{"RemoveRepoFromAll", testTeamRemoveRepoFromAll},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			c.run(t, factory(t))
+		})
+	}
+}
+
+// NewTeam builds an empty team; timestamps are truncated to what every backend can store
+func NewTeam(orgID primitive.ObjectID, slug string) *model.Team {
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	return &model.Team{
+		OrgID:     orgID,
+		Slug:      slug,
+		Name:      "Team " + slug,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func mustCreateTeam(t *testing.T, teams repository.TeamRepository, team *model.Team) *model.Team {
+	created, err := teams.Create(context.Background(), team)
+	require.NoError(t, err)
+
+	return created
+}
+
+func testTeamCreateAndFind(t *testing.T, teams repository.TeamRepository) {
+	created := mustCreateTeam(t, teams, NewTeam(primitive.NewObjectID(), "core"))
+	assert.False(t, created.ID.IsZero())
+
+	found, err := teams.Find(context.Background(), created.OrgID, "core")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, found.ID)
+	assert.Empty(t, found.Members)
+	assert.Empty(t, found.Repos)
+	assert.True(t, created.CreatedAt.Equal(found.CreatedAt))
+}
+
+func testTeamCreateDuplicateSlug(t *testing.T, teams repository.TeamRepository) {
+	created := mustCreateTeam(t, teams, NewTeam(primitive.NewObjectID(), "core"))
+
+	_, err := teams.Create(context.Background(), NewTeam(created.OrgID, "core"))
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+
+	// Another organization may use the same slug
+	mustCreateTeam(t, teams, NewTeam(primitive.NewObjectID(), "core"))
+}
+
+func testTeamFindMissing(t *testing.T, teams repository.TeamRepository) {
+	_, err := teams.Find(context.Background(), primitive.NewObjectID(), "core")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testTeamListByOrg(t *testing.T, teams repository.TeamRepository) {
+	orgID := primitive.NewObjectID()
+	mustCreateTeam(t, teams, NewTeam(orgID, "first"))
+	mustCreateTeam(t, teams, NewTeam(orgID, "second"))
+	mustCreateTeam(t, teams, NewTeam(primitive.NewObjectID(), "elsewhere"))
+
+	listed, err := teams.ListByOrg(context.Background(), orgID)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	assert.Equal(t, "first", listed[0].Slug)
+	assert.Equal(t, "second", listed[1].Slug)
+}
+
+func testTeamMembers(t *testing.T, teams repository.TeamRepository) {
+	created := mustCreateTeam(t, teams, NewTeam(primitive.NewObjectID(), "core"))
+	updatedAt := created.UpdatedAt.Add(time.Minute)
+
+	updated, err := teams.AddMember(context.Background(), created.ID, "user", updatedAt)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user"}, updated.Members)
+	assert.True(t, updatedAt.Equal(updated.UpdatedAt))
+
+	// Adding twice keeps a single membership
+	updated, err = teams.AddMember(context.Background(), created.ID, "user", updatedAt)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user"}, updated.Members)
+
+	listed, err := teams.ListByMember(context.Background(), "user")
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, created.ID, listed[0].ID)
+
+	updated, err = teams.RemoveMember(context.Background(), created.ID, "user", updatedAt)
+	require.NoError(t, err)
+	assert.Empty(t, updated.Members)
+
+	listed, err = teams.ListByMember(context.Background(), "user")
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+}
+
+func testTeamRemoveMemberMissing(t *testing.T, teams repository.TeamRepository) {
+	created := mustCreateTeam(t, teams, NewTeam(primitive.NewObjectID(), "core"))
+
+	_, err := teams.RemoveMember(context.Background(), created.ID, "user", time.Now())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = teams.AddMember(context.Background(), primitive.NewObjectID(), "user", time.Now())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testTeamRemoveOrgMember(t *testing.T, teams repository.TeamRepository) {
+	orgID := primitive.NewObjectID()
+	first := mustCreateTeam(t, teams, NewTeam(orgID, "first"))
+	second := mustCreateTeam(t, teams, NewTeam(orgID, "second"))
+	elsewhere := mustCreateTeam(t, teams, NewTeam(primitive.NewObjectID(), "elsewhere"))
+	for _, team := range []*model.Team{first, second, elsewhere} {
+		_, err := teams.AddMember(context.Background(), team.ID, "user", time.Now())
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, teams.RemoveOrgMember(context.Background(), orgID, "user"))
+
+	listed, err := teams.ListByMember(context.Background(), "user")
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	assert.Equal(t, elsewhere.ID, listed[0].ID)
+}
+
+func testTeamRepos(t *testing.T, teams repository.TeamRepository) {
+	created := mustCreateTeam(t, teams, NewTeam(primitive.NewObjectID(), "core"))
+	repoID := primitive.NewObjectID()
+	_, err := teams.AddMember(context.Background(), created.ID, "user", time.Now())
+	require.NoError(t, err)
+
+	updated, err := teams.SetRepoRole(context.Background(), created.ID, repoID, model.CollaboratorRead, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, []model.TeamRepo{{RepoID: repoID, Role: model.CollaboratorRead}}, updated.Repos)
+
+	// Setting again changes the role in place
+	updated, err = teams.SetRepoRole(context.Background(), created.ID, repoID, model.CollaboratorWrite, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, []model.TeamRepo{{RepoID: repoID, Role: model.CollaboratorWrite}}, updated.Repos)
+
+	listed, err := teams.ListByRepoAndMember(context.Background(), repoID, "user")
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+
+	listed, err = teams.ListByRepoAndMember(context.Background(), repoID, "someone")
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+
+	updated, err = teams.RemoveRepo(context.Background(), created.ID, repoID, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, updated.Repos)
+
+	_, err = teams.RemoveRepo(context.Background(), created.ID, repoID, time.Now())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = teams.SetRepoRole(context.Background(), primitive.NewObjectID(), repoID, model.CollaboratorRead, time.Now())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testTeamRemoveRepoFromAll(t *testing.T, teams repository.TeamRepository) {
+	orgID, repoID, otherID := primitive.NewObjectID(), primitive.NewObjectID(), primitive.NewObjectID()
+	first := mustCreateTeam(t, teams, NewTeam(orgID, "first"))
+	second := mustCreateTeam(t, teams, NewTeam(orgID, "second"))
+	for _, team := range []*model.Team{first, second} {
+		_, err := teams.AddMember(context.Background(), team.ID, "user", time.Now())
+		require.NoError(t, err)
+		_, err = teams.SetRepoRole(context.Background(), team.ID, repoID, model.CollaboratorRead, time.Now())
+		require.NoError(t, err)
+	}
+	_, err := teams.SetRepoRole(context.Background(), first.ID, otherID, model.CollaboratorRead, time.Now())
+	require.NoError(t, err)
+
+	require.NoError(t, teams.RemoveRepoFromAll(context.Background(), repoID))
+
+	listed, err := teams.ListByRepoAndMember(context.Background(), repoID, "user")
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+
+	listed, err = teams.ListByRepoAndMember(context.Background(), otherID, "user")
+	require.NoError(t, err)
+	assert.Len(t, listed, 1)
+}
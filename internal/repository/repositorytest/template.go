@@ -0,0 +1,72 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Template fields survive a round trip through Create
+func testCreateFromTemplate(t *testing.T, repo repository.RepoRepository) {
+	template := NewRepo("template")
+	template.IsTemplate = true
+	template.Topics = []string{"go", "service"}
+	template.Properties = map[string]string{"team": "platform"}
+	template = mustCreate(t, repo, template)
+
+	toCreate := NewRepo("instance")
+	toCreate.TemplateID = &template.ID
+	created := mustCreate(t, repo, toCreate)
+
+	found, err := repo.FindById(context.Background(), template.ID.Hex())
+	require.NoError(t, err)
+	assert.True(t, found.IsTemplate)
+	assert.Equal(t, []string{"go", "service"}, found.Topics)
+	assert.Equal(t, map[string]string{"team": "platform"}, found.Properties)
+
+	found, err = repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.False(t, found.IsTemplate)
+	assert.Equal(t, template.ID, *found.TemplateID)
+}
+
+// Topics and properties are replaced as a whole, other fields are left alone
+func testPatchOneTemplateFields(t *testing.T, repo repository.RepoRepository) {
+	toCreate := NewRepo("conformance")
+	toCreate.Topics = []string{"old"}
+	toCreate.Properties = map[string]string{"team": "platform", "tier": "1"}
+	created := mustCreate(t, repo, toCreate)
+
+	isTemplate := true
+	topics := []string{"go", "grpc"}
+	properties := map[string]string{"team": "payments"}
+	patched, err := repo.PatchOne(context.Background(), created.ID.Hex(), &model.RepoPatch{
+		IsTemplate: &isTemplate,
+		Topics:     &topics,
+		Properties: &properties,
+		UpdatedAt:  time.Now(),
+	})
+	require.NoError(t, err)
+	assert.True(t, patched.IsTemplate)
+
+	found, err := repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.True(t, found.IsTemplate)
+	assert.Equal(t, []string{"go", "grpc"}, found.Topics)
+	assert.Equal(t, map[string]string{"team": "payments"}, found.Properties)
+	assert.Equal(t, created.Description, found.Description)
+
+	isTemplate = false
+	_, err = repo.PatchOne(context.Background(), created.ID.Hex(), &model.RepoPatch{IsTemplate: &isTemplate, UpdatedAt: time.Now()})
+	require.NoError(t, err)
+
+	found, err = repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.False(t, found.IsTemplate)
+	assert.Equal(t, []string{"go", "grpc"}, found.Topics)
+}
@@ -0,0 +1,151 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func mustTrash(t *testing.T, repo repository.RepoRepository, id primitive.ObjectID, deletedAt time.Time) *model.PrivateRepoModel {
+	trashed, err := repo.Trash(context.Background(), id.Hex(), deletedAt)
+	require.NoError(t, err)
+
+	return trashed
+}
+
+// Repos in the trash are only found by FindTrashedById and ListTrashed
+func testTrash(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+	deletedAt := time.Now().UTC().Truncate(time.Millisecond)
+
+	trashed := mustTrash(t, repo, created.ID, deletedAt)
+	require.True(t, trashed.Trashed())
+	assert.True(t, deletedAt.Equal(*trashed.DeletedAt))
+	assert.Equal(t, created.Version+1, trashed.Version)
+
+	_, err := repo.FindById(context.Background(), created.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = repo.FindByName(context.Background(), "conformance")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = repo.FindByOwnerAndName(context.Background(), created.OwnerID, "conformance")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	page, err := repo.List(context.Background(), &model.RepoListQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, page.Repos)
+
+	description := "patched"
+	_, err = repo.PatchOne(context.Background(), created.ID.Hex(), &model.RepoPatch{Description: &description})
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	found, err := repo.FindTrashedById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assertSameRepo(t, created, found)
+	assert.True(t, deletedAt.Equal(*found.DeletedAt))
+}
+
+// The name of a repo in the trash can neither be taken nor resembled
+func testTrashReservesName(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+	mustTrash(t, repo, created.ID, time.Now())
+
+	duplicate := NewRepo("conformance")
+	duplicate.OwnerID = created.OwnerID
+	_, err := repo.Create(context.Background(), duplicate)
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+
+	similar, err := repo.FindByOwnerAndSkeleton(context.Background(), created.OwnerID, created.Skeleton)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, similar.ID)
+	assert.True(t, similar.Trashed())
+}
+
+func testTrashMissing(t *testing.T, repo repository.RepoRepository) {
+	_, err := repo.Trash(context.Background(), primitive.NewObjectID().Hex(), time.Now())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	created := mustCreate(t, repo, NewRepo("conformance"))
+	mustTrash(t, repo, created.ID, time.Now())
+
+	_, err = repo.Trash(context.Background(), created.ID.Hex(), time.Now())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = repo.Trash(context.Background(), "invalid", time.Now())
+	assert.ErrorIs(t, err, repoerr.ErrInvalidID)
+}
+
+func testRestore(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+	mustTrash(t, repo, created.ID, time.Now())
+
+	restored, err := repo.Restore(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assert.False(t, restored.Trashed())
+	assert.Equal(t, created.Version+2, restored.Version)
+
+	found, err := repo.FindById(context.Background(), created.ID.Hex())
+	require.NoError(t, err)
+	assertSameRepo(t, created, found)
+	assert.False(t, found.Trashed())
+
+	_, err = repo.FindTrashedById(context.Background(), created.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testRestoreNotTrashed(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+
+	_, err := repo.Restore(context.Background(), created.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = repo.Restore(context.Background(), primitive.NewObjectID().Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func testListTrashed(t *testing.T, repo repository.RepoRepository) {
+	base := time.Now().UTC().Truncate(time.Millisecond)
+	for _, tc := range []struct {
+		name   string
+		offset time.Duration
+	}{
+		{"newer", time.Hour},
+		{"older", 0},
+		{"newest", 2 * time.Hour},
+	} {
+		created := mustCreate(t, repo, NewRepo(tc.name))
+		mustTrash(t, repo, created.ID, base.Add(tc.offset))
+	}
+	mustCreate(t, repo, NewRepo("live"))
+
+	repos, err := repo.ListTrashed(context.Background(), base.Add(90*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"older", "newer"}, names(repos))
+
+	repos, err = repo.ListTrashed(context.Background(), base)
+	require.NoError(t, err)
+	assert.Empty(t, repos)
+}
+
+func testDeleteOneTrashed(t *testing.T, repo repository.RepoRepository) {
+	created := mustCreate(t, repo, NewRepo("conformance"))
+	mustTrash(t, repo, created.ID, time.Now())
+
+	require.NoError(t, repo.DeleteOne(context.Background(), created))
+
+	_, err := repo.FindTrashedById(context.Background(), created.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	// The name is free again once the repo is purged
+	replacement := NewRepo("conformance")
+	replacement.OwnerID = created.OwnerID
+	mustCreate(t, repo, replacement)
+}
@@ -0,0 +1,288 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"sort"
+	"sync"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SubscriptionRepository stores which users starred or watch which repos. A user
+// holds each kind of subscription at most once per repo, so adding and removing
+// report whether anything changed and the counts on the repo can follow.
+type SubscriptionRepository interface {
+	Add(ctx context.Context, subscription *model.Subscription) (added bool, err error)
+	Remove(ctx context.Context, repoID primitive.ObjectID, userID string, kind string) (removed bool, err error)
+	ListByRepo(ctx context.Context, repoID primitive.ObjectID, kind string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error)
+	ListByUser(ctx context.Context, userID string, kind string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error)
+	Count(ctx context.Context, repoID primitive.ObjectID, kind string) (int64, error)
+	RemoveByRepo(ctx context.Context, repoID primitive.ObjectID) error
+}
+
+type MongoSubscriptionRepository struct {
+	Collection MongoCollection
+}
+
+func NewSubscriptionRepository(collection MongoCollection) *MongoSubscriptionRepository {
+	return &MongoSubscriptionRepository{
+		Collection: collection,
+	}
+}
+
+// EnsureIndexes creates the unique (repo_id, kind, user_id) index and the ones paging
+// through the subscribers of a repo and the subscriptions of a user
+func (m *MongoSubscriptionRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := m.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "repo_id", Value: 1}, {Key: "kind", Value: 1}, {Key: "user_id", Value: 1}},
+			Options: options.Index().SetName("repo_id_kind_user_id_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "repo_id", Value: 1}, {Key: "kind", Value: 1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("repo_id_kind_id"),
+		},
+		{
+			Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "kind", Value: 1}, {Key: "_id", Value: -1}},
+			Options: options.Index().SetName("user_id_kind_id"),
+		},
+	})
+	return mapMongoError(err, "subscription")
+}
+
+// Add stores the subscription unless the user already holds it. Of two concurrent
+// adds only one reports added.
+func (m *MongoSubscriptionRepository) Add(ctx context.Context, subscription *model.Subscription) (bool, error) {
+	if subscription.ID.IsZero() {
+		subscription.ID = primitive.NewObjectID()
+	}
+
+	result, err := m.Collection.UpdateOne(ctx,
+		bson.M{"repo_id": subscription.RepoID, "kind": subscription.Kind, "user_id": subscription.UserID},
+		bson.M{"$setOnInsert": bson.M{"_id": subscription.ID, "created_at": subscription.CreatedAt}},
+		options.Update().SetUpsert(true),
+	)
+	switch {
+	case mongo.IsDuplicateKeyError(err):
+		return false, nil
+	case err != nil:
+		return false, mapMongoError(err, "subscription")
+	}
+
+	return result.UpsertedCount > 0, nil
+}
+
+func (m *MongoSubscriptionRepository) Remove(ctx context.Context, repoID primitive.ObjectID, userID string, kind string) (bool, error) {
+	result, err := m.Collection.DeleteOne(ctx, bson.M{"repo_id": repoID, "kind": kind, "user_id": userID})
+	if err != nil {
+		return false, mapMongoError(err, "subscription")
+	}
+
+	return result.DeletedCount > 0, nil
+}
+
+// ListByRepo pages through the subscriptions of one kind to a repo, newest first
+func (m *MongoSubscriptionRepository) ListByRepo(ctx context.Context, repoID primitive.ObjectID, kind string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error) {
+	return m.list(ctx, bson.M{"repo_id": repoID, "kind": kind}, query)
+}
+
+// ListByUser pages through the subscriptions of one kind a user holds, newest first
+func (m *MongoSubscriptionRepository) ListByUser(ctx context.Context, userID string, kind string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error) {
+	return m.list(ctx, bson.M{"user_id": userID, "kind": kind}, query)
+}
+
+func (m *MongoSubscriptionRepository) list(ctx context.Context, filter bson.M, query *model.SubscriptionQuery) (*model.SubscriptionPage, error) {
+	if query.Cursor != "" {
+		after, err := decodeSubscriptionCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		filter["_id"] = bson.M{"$lt": after}
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: -1}})
+	if query.Limit > 0 {
+		opts.SetLimit(int64(query.Limit) + 1)
+	}
+
+	cursor, err := m.Collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, mapMongoError(err, "subscription")
+	}
+
+	subscriptions := []*model.Subscription{}
+	if err := cursor.All(ctx, &subscriptions); err != nil {
+		return nil, mapMongoError(err, "subscription")
+	}
+
+	return newSubscriptionPage(query, subscriptions), nil
+}
+
+func (m *MongoSubscriptionRepository) Count(ctx context.Context, repoID primitive.ObjectID, kind string) (int64, error) {
+	count, err := m.Collection.CountDocuments(ctx, bson.M{"repo_id": repoID, "kind": kind})
+	if err != nil {
+		return 0, mapMongoError(err, "subscription")
+	}
+
+	return count, nil
+}
+
+func (m *MongoSubscriptionRepository) RemoveByRepo(ctx context.Context, repoID primitive.ObjectID) error {
+	_, err := m.Collection.DeleteMany(ctx, bson.M{"repo_id": repoID})
+	return mapMongoError(err, "subscription")
+}
+
+// newSubscriptionPage trims a result fetched with one subscription more than the
+// limit and points the cursor at the last one kept
+func newSubscriptionPage(query *model.SubscriptionQuery, subscriptions []*model.Subscription) *model.SubscriptionPage {
+	page := &model.SubscriptionPage{Subscriptions: subscriptions}
+	if query.Limit > 0 && len(subscriptions) > query.Limit {
+		page.Subscriptions = subscriptions[:query.Limit]
+		page.NextCursor = base64.RawURLEncoding.EncodeToString([]byte(page.Subscriptions[query.Limit-1].ID.Hex()))
+	}
+
+	return page
+}
+
+func decodeSubscriptionCursor(cursor string) (primitive.ObjectID, error) {
+	invalid := repoerr.Validation(repoerr.Violation{Field: "cursor", Message: "is invalid"})
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return primitive.NilObjectID, invalid
+	}
+
+	id, err := primitive.ObjectIDFromHex(string(data))
+	if err != nil {
+		return primitive.NilObjectID, invalid
+	}
+
+	return id, nil
+}
+
+// MemorySubscriptionRepository keeps subscriptions in process memory
+type MemorySubscriptionRepository struct {
+	mu            sync.RWMutex
+	subscriptions map[subscriptionKey]*model.Subscription
+}
+
+type subscriptionKey struct {
+	repoID primitive.ObjectID
+	kind   string
+	userID string
+}
+
+func NewMemorySubscriptionRepository() *MemorySubscriptionRepository {
+	return &MemorySubscriptionRepository{
+		subscriptions: map[subscriptionKey]*model.Subscription{},
+	}
+}
+
+func (m *MemorySubscriptionRepository) Add(ctx context.Context, subscription *model.Subscription) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := subscriptionKey{subscription.RepoID, subscription.Kind, subscription.UserID}
+	if _, ok := m.subscriptions[key]; ok {
+		return false, nil
+	}
+
+	if subscription.ID.IsZero() {
+		subscription.ID = primitive.NewObjectID()
+	}
+	copied := *subscription
+	m.subscriptions[key] = &copied
+
+	return true, nil
+}
+
+func (m *MemorySubscriptionRepository) Remove(ctx context.Context, repoID primitive.ObjectID, userID string, kind string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := subscriptionKey{repoID, kind, userID}
+	if _, ok := m.subscriptions[key]; !ok {
+		return false, nil
+	}
+	delete(m.subscriptions, key)
+
+	return true, nil
+}
+
+func (m *MemorySubscriptionRepository) ListByRepo(ctx context.Context, repoID primitive.ObjectID, kind string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error) {
+	return m.list(func(key subscriptionKey) bool { return key.repoID == repoID && key.kind == kind }, query)
+}
+
+func (m *MemorySubscriptionRepository) ListByUser(ctx context.Context, userID string, kind string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error) {
+	return m.list(func(key subscriptionKey) bool { return key.userID == userID && key.kind == kind }, query)
+}
+
+func (m *MemorySubscriptionRepository) list(matches func(key subscriptionKey) bool, query *model.SubscriptionQuery) (*model.SubscriptionPage, error) {
+	var after *primitive.ObjectID
+	if query.Cursor != "" {
+		id, err := decodeSubscriptionCursor(query.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = &id
+	}
+
+	m.mu.RLock()
+	subscriptions := []*model.Subscription{}
+	for key, subscription := range m.subscriptions {
+		if !matches(key) {
+			continue
+		}
+		if after != nil && bytes.Compare(subscription.ID[:], after[:]) >= 0 {
+			continue
+		}
+
+		copied := *subscription
+		subscriptions = append(subscriptions, &copied)
+	}
+	m.mu.RUnlock()
+
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return bytes.Compare(subscriptions[i].ID[:], subscriptions[j].ID[:]) > 0
+	})
+
+	if query.Limit > 0 && len(subscriptions) > query.Limit+1 {
+		subscriptions = subscriptions[:query.Limit+1]
+	}
+
+	return newSubscriptionPage(query, subscriptions), nil
+}
+
+func (m *MemorySubscriptionRepository) Count(ctx context.Context, repoID primitive.ObjectID, kind string) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	count := int64(0)
+	for key := range m.subscriptions {
+		if key.repoID == repoID && key.kind == kind {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (m *MemorySubscriptionRepository) RemoveByRepo(ctx context.Context, repoID primitive.ObjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key := range m.subscriptions {
+		if key.repoID == repoID {
+			delete(m.subscriptions, key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,398 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TeamRepository stores the teams of organizations along with their members and
+// the roles they hold on repos. Slugs are unique per organization.
+type TeamRepository interface {
+	Create(ctx context.Context, team *model.Team) (*model.Team, error)
+	Find(ctx context.Context, orgID primitive.ObjectID, slug string) (*model.Team, error)
+	ListByOrg(ctx context.Context, orgID primitive.ObjectID) ([]*model.Team, error)
+	ListByMember(ctx context.Context, userID string) ([]*model.Team, error)
+	ListByRepoAndMember(ctx context.Context, repoID primitive.ObjectID, userID string) ([]*model.Team, error)
+	AddMember(ctx context.Context, teamID primitive.ObjectID, userID string, updatedAt time.Time) (*model.Team, error)
+	RemoveMember(ctx context.Context, teamID primitive.ObjectID, userID string, updatedAt time.Time) (*model.Team, error)
+	RemoveOrgMember(ctx context.Context, orgID primitive.ObjectID, userID string) error
+	SetRepoRole(ctx context.Context, teamID primitive.ObjectID, repoID primitive.ObjectID, role string, updatedAt time.Time) (*model.Team, error)
+	RemoveRepo(ctx context.Context, teamID primitive.ObjectID, repoID primitive.ObjectID, updatedAt time.Time) (*model.Team, error)
+	RemoveRepoFromAll(ctx context.Context, repoID primitive.ObjectID) error
+}
+
+type MongoTeamRepository struct {
+	Collection MongoCollection
+}
+
+func NewTeamRepository(collection MongoCollection) *MongoTeamRepository {
+	return &MongoTeamRepository{
+		Collection: collection,
+	}
+}
+
+// EnsureIndexes creates the unique (org_id, slug) index and the indexes used to find
+// the teams of a user and of a repo
+func (m *MongoTeamRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := m.Collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "org_id", Value: 1}, {Key: "slug", Value: 1}},
+			Options: options.Index().SetName("org_id_slug_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "members", Value: 1}},
+			Options: options.Index().SetName("members"),
+		},
+		{
+			Keys:    bson.D{{Key: "repos.repo_id", Value: 1}},
+			Options: options.Index().SetName("repos_repo_id"),
+		},
+	})
+	return mapMongoError(err, "team")
+}
+
+func (m *MongoTeamRepository) Create(ctx context.Context, team *model.Team) (*model.Team, error) {
+	if team.ID.IsZero() {
+		team.ID = primitive.NewObjectID()
+	}
+	// $push and $addToSet fail on null arrays
+	if team.Members == nil {
+		team.Members = []string{}
+	}
+	if team.Repos == nil {
+		team.Repos = []model.TeamRepo{}
+	}
+
+	if _, err := m.Collection.InsertOne(ctx, team); err != nil {
+		return nil, mapTeamError(err)
+	}
+
+	return team, nil
+}
+
+func (m *MongoTeamRepository) Find(ctx context.Context, orgID primitive.ObjectID, slug string) (*model.Team, error) {
+	team := &model.Team{}
+	if err := m.Collection.FindOne(ctx, bson.M{"org_id": orgID, "slug": slug}).Decode(team); err != nil {
+		return nil, mapTeamError(err)
+	}
+
+	return team, nil
+}
+
+// ListByOrg returns the teams of an organization, oldest first
+func (m *MongoTeamRepository) ListByOrg(ctx context.Context, orgID primitive.ObjectID) ([]*model.Team, error) {
+	return m.list(ctx, bson.M{"org_id": orgID})
+}
+
+func (m *MongoTeamRepository) ListByMember(ctx context.Context, userID string) ([]*model.Team, error) {
+	return m.list(ctx, bson.M{"members": userID})
+}
+
+// ListByRepoAndMember returns the teams of userID that hold a role on repoID
+func (m *MongoTeamRepository) ListByRepoAndMember(ctx context.Context, repoID primitive.ObjectID, userID string) ([]*model.Team, error) {
+	return m.list(ctx, bson.M{"repos.repo_id": repoID, "members": userID})
+}
+
+func (m *MongoTeamRepository) list(ctx context.Context, filter bson.M) ([]*model.Team, error) {
+	cursor, err := m.Collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, mapMongoError(err, "team")
+	}
+
+	teams := []*model.Team{}
+	if err := cursor.All(ctx, &teams); err != nil {
+		return nil, mapMongoError(err, "team")
+	}
+
+	return teams, nil
+}
+
+func (m *MongoTeamRepository) AddMember(ctx context.Context, teamID primitive.ObjectID, userID string, updatedAt time.Time) (*model.Team, error) {
+	return m.update(ctx, bson.M{"_id": teamID},
+		bson.M{"$addToSet": bson.M{"members": userID}, "$set": bson.M{"updated_at": updatedAt}})
+}
+
+func (m *MongoTeamRepository) RemoveMember(ctx context.Context, teamID primitive.ObjectID, userID string, updatedAt time.Time) (*model.Team, error) {
+	team, err := m.update(ctx, bson.M{"_id": teamID, "members": userID},
+		bson.M{"$pull": bson.M{"members": userID}, "$set": bson.M{"updated_at": updatedAt}})
+	if errors.Is(err, repoerr.ErrNotFound) {
+		return nil, m.missing(ctx, teamID, "member not found")
+	}
+
+	return team, err
+}
+
+// RemoveOrgMember takes a user out of every team of an organization
+func (m *MongoTeamRepository) RemoveOrgMember(ctx context.Context, orgID primitive.ObjectID, userID string) error {
+	_, err := m.Collection.UpdateMany(ctx, bson.M{"org_id": orgID, "members": userID}, bson.M{"$pull": bson.M{"members": userID}})
+	return mapMongoError(err, "team")
+}
+
+// SetRepoRole grants the team a role on a repo or changes the one it holds
+func (m *MongoTeamRepository) SetRepoRole(ctx context.Context, teamID primitive.ObjectID, repoID primitive.ObjectID, role string, updatedAt time.Time) (*model.Team, error) {
+	// Changing the role and granting are two updates, the second attempt at changing
+	// the role catches a grant added concurrently in between
+	for attempt := 0; attempt < 2; attempt++ {
+		team, err := m.update(ctx, bson.M{"_id": teamID, "repos.repo_id": repoID},
+			bson.M{"$set": bson.M{"repos.$.role": role, "updated_at": updatedAt}})
+		if !errors.Is(err, repoerr.ErrNotFound) {
+			return team, err
+		}
+
+		team, err = m.update(ctx, bson.M{"_id": teamID, "repos.repo_id": bson.M{"$ne": repoID}},
+			bson.M{"$push": bson.M{"repos": model.TeamRepo{RepoID: repoID, Role: role}}, "$set": bson.M{"updated_at": updatedAt}})
+		if !errors.Is(err, repoerr.ErrNotFound) {
+			return team, err
+		}
+	}
+
+	return nil, repoerr.NotFound("team not found")
+}
+
+func (m *MongoTeamRepository) RemoveRepo(ctx context.Context, teamID primitive.ObjectID, repoID primitive.ObjectID, updatedAt time.Time) (*model.Team, error) {
+	team, err := m.update(ctx, bson.M{"_id": teamID, "repos.repo_id": repoID},
+		bson.M{"$pull": bson.M{"repos": bson.M{"repo_id": repoID}}, "$set": bson.M{"updated_at": updatedAt}})
+	if errors.Is(err, repoerr.ErrNotFound) {
+		return nil, m.missing(ctx, teamID, "team has no role on the repo")
+	}
+
+	return team, err
+}
+
+// RemoveRepoFromAll revokes the roles every team holds on a repo
+func (m *MongoTeamRepository) RemoveRepoFromAll(ctx context.Context, repoID primitive.ObjectID) error {
+	_, err := m.Collection.UpdateMany(ctx, bson.M{"repos.repo_id": repoID}, bson.M{"$pull": bson.M{"repos": bson.M{"repo_id": repoID}}})
+	return mapMongoError(err, "team")
+}
+
+func (m *MongoTeamRepository) update(ctx context.Context, filter bson.M, update bson.M) (*model.Team, error) {
+	team := &model.Team{}
+	err := m.Collection.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(team)
+	if err != nil {
+		return nil, mapTeamError(err)
+	}
+
+	return team, nil
+}
+
+// missing tells a missing team apart from a filter on its contents not matching
+func (m *MongoTeamRepository) missing(ctx context.Context, teamID primitive.ObjectID, message string) error {
+	err := m.Collection.FindOne(ctx, bson.M{"_id": teamID}).Decode(&model.Team{})
+	if err != nil {
+		return mapTeamError(err)
+	}
+
+	return repoerr.NotFound("%s", message)
+}
+
+// mapTeamError is mapMongoError with messages about teams
+func mapTeamError(err error) error {
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return repoerr.Wrap(repoerr.ErrNotFound, err, "team not found")
+	case mongo.IsDuplicateKeyError(err):
+		return repoerr.Wrap(repoerr.ErrConflict, err, "team slug is taken")
+	default:
+		return mapMongoError(err, "team")
+	}
+}
+
+// MemoryTeamRepository keeps teams in process memory
+type MemoryTeamRepository struct {
+	mu    sync.RWMutex
+	teams map[primitive.ObjectID]*model.Team
+}
+
+func NewMemoryTeamRepository() *MemoryTeamRepository {
+	return &MemoryTeamRepository{
+		teams: map[primitive.ObjectID]*model.Team{},
+	}
+}
+
+func cloneTeam(team *model.Team) *model.Team {
+	copied := *team
+	copied.Members = append([]string{}, team.Members...)
+	copied.Repos = append([]model.TeamRepo{}, team.Repos...)
+	return &copied
+}
+
+func (m *MemoryTeamRepository) Create(ctx context.Context, team *model.Team) (*model.Team, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, existing := range m.teams {
+		if existing.OrgID == team.OrgID && existing.Slug == team.Slug {
+			return nil, repoerr.Conflict("team slug is taken")
+		}
+	}
+
+	if team.ID.IsZero() {
+		team.ID = primitive.NewObjectID()
+	}
+	m.teams[team.ID] = cloneTeam(team)
+
+	return team, nil
+}
+
+func (m *MemoryTeamRepository) Find(ctx context.Context, orgID primitive.ObjectID, slug string) (*model.Team, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, team := range m.teams {
+		if team.OrgID == orgID && team.Slug == slug {
+			return cloneTeam(team), nil
+		}
+	}
+
+	return nil, repoerr.NotFound("team not found")
+}
+
+func (m *MemoryTeamRepository) ListByOrg(ctx context.Context, orgID primitive.ObjectID) ([]*model.Team, error) {
+	return m.list(func(team *model.Team) bool { return team.OrgID == orgID }), nil
+}
+
+func (m *MemoryTeamRepository) ListByMember(ctx context.Context, userID string) ([]*model.Team, error) {
+	return m.list(func(team *model.Team) bool { return team.HasMember(userID) }), nil
+}
+
+func (m *MemoryTeamRepository) ListByRepoAndMember(ctx context.Context, repoID primitive.ObjectID, userID string) ([]*model.Team, error) {
+	return m.list(func(team *model.Team) bool {
+		_, granted := team.RepoRole(repoID)
+		return granted && team.HasMember(userID)
+	}), nil
+}
+
+// list returns the matching teams oldest first, like the Mongo backend
+func (m *MemoryTeamRepository) list(match func(team *model.Team) bool) []*model.Team {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	teams := []*model.Team{}
+	for _, team := range m.teams {
+		if match(team) {
+			teams = append(teams, cloneTeam(team))
+		}
+	}
+
+	sort.Slice(teams, func(i, j int) bool {
+		return teams[i].ID.Hex() < teams[j].ID.Hex()
+	})
+
+	return teams
+}
+
+func (m *MemoryTeamRepository) AddMember(ctx context.Context, teamID primitive.ObjectID, userID string, updatedAt time.Time) (*model.Team, error) {
+	return m.update(teamID, updatedAt, func(team *model.Team) error {
+		if !team.HasMember(userID) {
+			team.Members = append(team.Members, userID)
+		}
+		return nil
+	})
+}
+
+func (m *MemoryTeamRepository) RemoveMember(ctx context.Context, teamID primitive.ObjectID, userID string, updatedAt time.Time) (*model.Team, error) {
+	return m.update(teamID, updatedAt, func(team *model.Team) error {
+		if !team.HasMember(userID) {
+			return repoerr.NotFound("member not found")
+		}
+		team.Members = without(team.Members, userID)
+		return nil
+	})
+}
+
+func (m *MemoryTeamRepository) RemoveOrgMember(ctx context.Context, orgID primitive.ObjectID, userID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, team := range m.teams {
+		if team.OrgID == orgID {
+			team.Members = without(team.Members, userID)
+		}
+	}
+
+	return nil
+}
+
+func (m *MemoryTeamRepository) SetRepoRole(ctx context.Context, teamID primitive.ObjectID, repoID primitive.ObjectID, role string, updatedAt time.Time) (*model.Team, error) {
+	return m.update(teamID, updatedAt, func(team *model.Team) error {
+		for i := range team.Repos {
+			if team.Repos[i].RepoID == repoID {
+				team.Repos[i].Role = role
+				return nil
+			}
+		}
+		team.Repos = append(team.Repos, model.TeamRepo{RepoID: repoID, Role: role})
+		return nil
+	})
+}
+
+func (m *MemoryTeamRepository) RemoveRepo(ctx context.Context, teamID primitive.ObjectID, repoID primitive.ObjectID, updatedAt time.Time) (*model.Team, error) {
+	return m.update(teamID, updatedAt, func(team *model.Team) error {
+		if _, ok := team.RepoRole(repoID); !ok {
+			return repoerr.NotFound("team has no role on the repo")
+		}
+		team.Repos = withoutRepo(team.Repos, repoID)
+		return nil
+	})
+}
+
+func (m *MemoryTeamRepository) RemoveRepoFromAll(ctx context.Context, repoID primitive.ObjectID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, team := range m.teams {
+		team.Repos = withoutRepo(team.Repos, repoID)
+	}
+
+	return nil
+}
+
+// update applies change to a team and bumps UpdatedAt, unless change fails
+func (m *MemoryTeamRepository) update(teamID primitive.ObjectID, updatedAt time.Time, change func(team *model.Team) error) (*model.Team, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	team, ok := m.teams[teamID]
+	if !ok {
+		return nil, repoerr.NotFound("team not found")
+	}
+
+	if err := change(team); err != nil {
+		return nil, err
+	}
+	team.UpdatedAt = updatedAt
+
+	return cloneTeam(team), nil
+}
+
+func without(userIDs []string, userID string) []string {
+	kept := []string{}
+	for _, id := range userIDs {
+		if id != userID {
+			kept = append(kept, id)
+		}
+	}
+
+	return kept
+}
+
+func withoutRepo(repos []model.TeamRepo, repoID primitive.ObjectID) []model.TeamRepo {
+	kept := []model.TeamRepo{}
+	for _, repo := range repos {
+		if repo.RepoID != repoID {
+			kept = append(kept, repo)
+		}
+	}
+
+	return kept
+}
@@ -31,6 +31,13 @@ func (f *FiberRouterAdapter) PUT(path string, handler router.HandlerFunc) {
 	})
 }
 
+func (f *FiberRouterAdapter) PATCH(path string, handler router.HandlerFunc) {
+	f.App.Patch(path, func(ctx *fiber.Ctx) error {
+		handler(&server.FiberContextAdapter{Ctx: ctx})
+		return nil
+	})
+}
+
 func (f *FiberRouterAdapter) DELETE(path string, handler router.HandlerFunc) {
 	f.App.Delete(path, func(ctx *fiber.Ctx) error {
 		handler(&server.FiberContextAdapter{Ctx: ctx})
@@ -0,0 +1,31 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/auth"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/gofiber/fiber/v2"
+)
+
+// Authenticate puts the caller of a bearer token into the user context. Requests
+// without a token stay anonymous, ones with an invalid token are rejected.
+func Authenticate(authenticator *auth.Authenticator) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		caller, ok, err := authenticator.AuthenticateHeader(ctx.Get(fiber.HeaderAuthorization))
+		if err != nil {
+			ctx.Set(fiber.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+			return ctx.Status(http.StatusUnauthorized).JSON(&public_repo.ErrorModel{
+				Error: repoerr.PublicMessage(err, http.StatusText(http.StatusUnauthorized)),
+			})
+		}
+
+		if ok {
+			ctx.SetUserContext(identity.NewContext(ctx.UserContext(), caller))
+		}
+
+		return ctx.Next()
+	}
+}
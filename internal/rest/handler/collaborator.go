@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/router"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/server"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+)
+
+type CollaboratorHandler struct {
+	Service service.CollaboratorService
+}
+
+func NewCollaboratorHandler(service service.CollaboratorService) *CollaboratorHandler {
+	return &CollaboratorHandler{
+		Service: service,
+	}
+}
+
+// Register has to run before RepoHandler.Register, whose /repos/:owner/:name
+// would otherwise catch /repos/:id/collaborators
+func (h *CollaboratorHandler) Register(r router.Router) {
+	r.GET("/repos/:id/collaborators", h.List)
+	r.POST("/repos/:id/collaborators", h.Add)
+	r.PUT("/repos/:id/collaborators/:user", h.SetRole)
+	r.DELETE("/repos/:id/collaborators/:user", h.Remove)
+}
+
+func (h *CollaboratorHandler) List(ctx server.HTTPContext) {
+	collaborators, err := h.Service.ListCollaborators(ctx.Context(), ctx.GetParam("id"))
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	response := &public_repo.CollaboratorListModel{Collaborators: make([]public_repo.CollaboratorModel, 0, len(collaborators))}
+	for _, collaborator := range collaborators {
+		response.Collaborators = append(response.Collaborators, collaborator.ToPublicCollaboratorModel())
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *CollaboratorHandler) Add(ctx server.HTTPContext) {
+	body := &public_repo.AddCollaboratorModel{}
+	if err := ctx.BindJSON(body); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	collaborator, err := h.Service.AddCollaborator(ctx.Context(), ctx.GetParam("id"), body.UserID, body.Role)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeCollaborator(ctx, http.StatusCreated, collaborator)
+}
+
+func (h *CollaboratorHandler) SetRole(ctx server.HTTPContext) {
+	body := &public_repo.CollaboratorRoleModel{}
+	if err := ctx.BindJSON(body); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	collaborator, err := h.Service.SetCollaboratorRole(ctx.Context(), ctx.GetParam("id"), ctx.GetParam("user"), body.Role)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeCollaborator(ctx, http.StatusOK, collaborator)
+}
+
+func (h *CollaboratorHandler) Remove(ctx server.HTTPContext) {
+	if err := h.Service.RemoveCollaborator(ctx.Context(), ctx.GetParam("id"), ctx.GetParam("user")); err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func writeCollaborator(ctx server.HTTPContext, code int, collaborator *model.Collaborator) {
+	ctx.JSON(code, collaborator.ToPublicCollaboratorModel())
+}
@@ -0,0 +1,145 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/handler"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CollaboratorServiceMock struct {
+	mock.Mock
+}
+
+func (s *CollaboratorServiceMock) ListCollaborators(ctx context.Context, repoID string) ([]*model.Collaborator, error) {
+	args := s.Called(ctx, repoID)
+	return args.Get(0).([]*model.Collaborator), args.Error(1)
+}
+
+func (s *CollaboratorServiceMock) AddCollaborator(ctx context.Context, repoID string, userID string, role string) (*model.Collaborator, error) {
+	args := s.Called(ctx, repoID, userID, role)
+	return args.Get(0).(*model.Collaborator), args.Error(1)
+}
+
+func (s *CollaboratorServiceMock) SetCollaboratorRole(ctx context.Context, repoID string, userID string, role string) (*model.Collaborator, error) {
+	args := s.Called(ctx, repoID, userID, role)
+	return args.Get(0).(*model.Collaborator), args.Error(1)
+}
+
+func (s *CollaboratorServiceMock) RemoveCollaborator(ctx context.Context, repoID string, userID string) error {
+	args := s.Called(ctx, repoID, userID)
+	return args.Error(0)
+}
+
+func newCollaborator(repoID primitive.ObjectID, userID string, role string) *model.Collaborator {
+	return &model.Collaborator{
+		ID:        primitive.NewObjectID(),
+		RepoID:    repoID,
+		UserID:    userID,
+		Role:      role,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+}
+
+func TestListCollaborators_Success(t *testing.T) {
+	serviceMock := new(CollaboratorServiceMock)
+	repoID := primitive.NewObjectID()
+	collaborator := newCollaborator(repoID, "user", model.CollaboratorRead)
+	ctx := newHTTPContext(map[string]string{"id": repoID.Hex()}, "")
+
+	serviceMock.On("ListCollaborators", mock.Anything, repoID.Hex()).Return([]*model.Collaborator{collaborator}, nil)
+
+	handler.NewCollaboratorHandler(serviceMock).List(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+	assert.Equal(t, &public_repo.CollaboratorListModel{
+		Collaborators: []public_repo.CollaboratorModel{collaborator.ToPublicCollaboratorModel()},
+	}, ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAddCollaborator_Created(t *testing.T) {
+	serviceMock := new(CollaboratorServiceMock)
+	repoID := primitive.NewObjectID()
+	collaborator := newCollaborator(repoID, "user", model.CollaboratorWrite)
+	ctx := newHTTPContext(map[string]string{"id": repoID.Hex()}, `{"userId": "user", "role": "write"}`)
+
+	serviceMock.On("AddCollaborator", mock.Anything, repoID.Hex(), "user", "write").Return(collaborator, nil)
+
+	handler.NewCollaboratorHandler(serviceMock).Add(ctx)
+
+	assert.Equal(t, http.StatusCreated, ctx.StatusCode)
+	assert.Equal(t, collaborator.ToPublicCollaboratorModel(), ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAddCollaborator_Error_InvalidRole(t *testing.T) {
+	serviceMock := new(CollaboratorServiceMock)
+	ctx := newHTTPContext(map[string]string{"id": primitive.NewObjectID().Hex()}, `{"userId": "user", "role": "owner"}`)
+
+	handler.NewCollaboratorHandler(serviceMock).Add(ctx)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, ctx.StatusCode)
+	assert.Equal(t, "role", ctx.Response.(*public_repo.ErrorModel).Fields[0].Field)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestAddCollaborator_Error_PermissionDenied(t *testing.T) {
+	serviceMock := new(CollaboratorServiceMock)
+	repoID := primitive.NewObjectID()
+	ctx := newHTTPContext(map[string]string{"id": repoID.Hex()}, `{"userId": "user", "role": "read"}`)
+
+	serviceMock.On("AddCollaborator", mock.Anything, repoID.Hex(), "user", "read").
+		Return((*model.Collaborator)(nil), repoerr.PermissionDenied("not allowed to manage the collaborators of this repo"))
+
+	handler.NewCollaboratorHandler(serviceMock).Add(ctx)
+
+	assert.Equal(t, http.StatusForbidden, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestSetCollaboratorRole_Success(t *testing.T) {
+	serviceMock := new(CollaboratorServiceMock)
+	repoID := primitive.NewObjectID()
+	collaborator := newCollaborator(repoID, "user", model.CollaboratorMaintain)
+	ctx := newHTTPContext(map[string]string{"id": repoID.Hex(), "user": "user"}, `{"role": "maintain"}`)
+
+	serviceMock.On("SetCollaboratorRole", mock.Anything, repoID.Hex(), "user", "maintain").Return(collaborator, nil)
+
+	handler.NewCollaboratorHandler(serviceMock).SetRole(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRemoveCollaborator(t *testing.T) {
+	serviceMock := new(CollaboratorServiceMock)
+	repoID := primitive.NewObjectID()
+
+	serviceMock.On("RemoveCollaborator", mock.Anything, repoID.Hex(), "user").Return(nil).Once()
+	serviceMock.On("RemoveCollaborator", mock.Anything, repoID.Hex(), "user").Return(repoerr.NotFound("collaborator not found")).Once()
+
+	ctx := newHTTPContext(map[string]string{"id": repoID.Hex(), "user": "user"}, "")
+	handler.NewCollaboratorHandler(serviceMock).Remove(ctx)
+	assert.Equal(t, http.StatusNoContent, ctx.StatusCode)
+
+	ctx = newHTTPContext(map[string]string{"id": repoID.Hex(), "user": "user"}, "")
+	handler.NewCollaboratorHandler(serviceMock).Remove(ctx)
+	assert.Equal(t, http.StatusNotFound, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/router"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/server"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+)
+
+type CountHandler struct {
+	Service service.CountService
+}
+
+func NewCountHandler(service service.CountService) *CountHandler {
+	return &CountHandler{
+		Service: service,
+	}
+}
+
+func (h *CountHandler) Register(r router.Router) {
+	r.POST("/repos/:id/reconcile-counts", h.ReconcileCounts)
+}
+
+func (h *CountHandler) ReconcileCounts(ctx server.HTTPContext) {
+	repo, err := h.Service.ReconcileCounts(ctx.Context(), ctx.GetParam("id"))
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeRepo(ctx, http.StatusOK, repo)
+}
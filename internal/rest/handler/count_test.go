@@ -0,0 +1,53 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/handler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type CountServiceMock struct {
+	mock.Mock
+}
+
+func (s *CountServiceMock) ReconcileCounts(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func TestReconcileCounts_Success(t *testing.T) {
+	serviceMock := new(CountServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	repo.StarsCount = 3
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, "")
+
+	serviceMock.On("ReconcileCounts", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	handler.NewCountHandler(serviceMock).ReconcileCounts(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+	assert.Equal(t, repo.ToPublicRepoModel(), ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestReconcileCounts_Error_NotAdmin(t *testing.T) {
+	serviceMock := new(CountServiceMock)
+	id := primitive.NewObjectID().Hex()
+	ctx := newHTTPContext(map[string]string{"id": id}, "")
+
+	serviceMock.On("ReconcileCounts", mock.Anything, id).Return((*model.PrivateRepoModel)(nil), repoerr.PermissionDenied("only admins reconcile counts"))
+
+	handler.NewCountHandler(serviceMock).ReconcileCounts(ctx)
+
+	assert.Equal(t, http.StatusForbidden, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/server"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/validation"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+)
+
+func writeServiceError(ctx server.HTTPContext, err error) {
+	code := statusCode(err)
+	if code == http.StatusInternalServerError {
+		writeError(ctx, code, "internal error")
+		return
+	}
+
+	response := &public_repo.ErrorModel{Error: repoerr.PublicMessage(err, http.StatusText(code))}
+	for _, violation := range repoerr.ViolationsOf(err) {
+		response.Fields = append(response.Fields, public_repo.FieldErrorModel{
+			Field:   violation.Field,
+			Message: violation.Message,
+		})
+	}
+
+	ctx.JSON(code, response)
+}
+
+// writeBindError reports a body that failed its binding tags field by field, a model
+// whose tags do not parse as an internal error and any other bind failure as an
+// unparsable body
+func writeBindError(ctx server.HTTPContext, err error) {
+	if errors.Is(err, repoerr.ErrValidationFailed) || errors.Is(err, validation.ErrInvalidTag) {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeError(ctx, http.StatusUnprocessableEntity, "invalid request body")
+}
+
+func statusCode(err error) int {
+	switch {
+	case errors.Is(err, repoerr.ErrNotFound), errors.Is(err, repoerr.ErrInvalidID):
+		return http.StatusNotFound
+	case errors.Is(err, repoerr.ErrConflict), errors.Is(err, repoerr.ErrReadOnly):
+		return http.StatusConflict
+	case errors.Is(err, repoerr.ErrValidationFailed):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, repoerr.ErrPermissionDenied):
+		return http.StatusForbidden
+	case errors.Is(err, repoerr.ErrUnauthenticated):
+		return http.StatusUnauthorized
+	case errors.Is(err, repoerr.ErrUnavailable):
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeError(ctx server.HTTPContext, code int, message string) {
+	ctx.JSON(code, &public_repo.ErrorModel{Error: message})
+}
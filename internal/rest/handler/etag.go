@@ -0,0 +1,29 @@
+package handler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+)
+
+func etag(repo *model.PrivateRepoModel) string {
+	return fmt.Sprintf(`"%d"`, repo.Version)
+}
+
+// parseETag reads the repo version out of an If-Match value; weak validators are
+// accepted since the version identifies the representation
+func parseETag(value string) (int64, bool) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+	if len(value) < 2 || !strings.HasPrefix(value, `"`) || !strings.HasSuffix(value, `"`) {
+		return 0, false
+	}
+
+	version, err := strconv.ParseInt(value[1:len(value)-1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return version, true
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/router"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/server"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+)
+
+type ForkHandler struct {
+	Service service.ForkService
+}
+
+func NewForkHandler(service service.ForkService) *ForkHandler {
+	return &ForkHandler{
+		Service: service,
+	}
+}
+
+// Register has to run before RepoHandler.Register, whose /repos/:owner/:name
+// would otherwise catch /repos/:id/forks and /repos/:id/network
+func (h *ForkHandler) Register(r router.Router) {
+	r.POST("/repos/:id/forks", h.Fork)
+	r.GET("/repos/:id/forks", h.ListForks)
+	r.GET("/repos/:id/network", h.ListNetwork)
+}
+
+func (h *ForkHandler) Fork(ctx server.HTTPContext) {
+	body := &public_repo.ForkRepoModel{}
+	if err := ctx.BindJSON(body); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	fork, err := h.Service.Fork(ctx.Context(), ctx.GetParam("id"), &model.RepoFork{Name: body.Name, Organization: body.Organization})
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeRepo(ctx, http.StatusCreated, fork)
+}
+
+func (h *ForkHandler) ListForks(ctx server.HTTPContext) {
+	h.list(ctx, h.Service.ListForks)
+}
+
+func (h *ForkHandler) ListNetwork(ctx server.HTTPContext) {
+	h.list(ctx, h.Service.ListNetwork)
+}
+
+func (h *ForkHandler) list(ctx server.HTTPContext, list func(ctx context.Context, id string, query *model.RepoListQuery) (*model.RepoPage, error)) {
+	query, err := parseListQuery(ctx)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	page, err := list(ctx.Context(), ctx.GetParam("id"), query)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writePage(ctx, page)
+}
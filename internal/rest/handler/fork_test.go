@@ -0,0 +1,100 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/handler"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ForkServiceMock struct {
+	mock.Mock
+}
+
+func (s *ForkServiceMock) Fork(ctx context.Context, id string, fork *model.RepoFork) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id, fork)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *ForkServiceMock) ListForks(ctx context.Context, id string, query *model.RepoListQuery) (*model.RepoPage, error) {
+	args := s.Called(ctx, id, query)
+	return args.Get(0).(*model.RepoPage), args.Error(1)
+}
+
+func (s *ForkServiceMock) ListNetwork(ctx context.Context, id string, query *model.RepoListQuery) (*model.RepoPage, error) {
+	args := s.Called(ctx, id, query)
+	return args.Get(0).(*model.RepoPage), args.Error(1)
+}
+
+func TestFork_Created(t *testing.T) {
+	serviceMock := new(ForkServiceMock)
+	parentID := primitive.NewObjectID()
+	fork := newRepo(primitive.NewObjectID().Hex())
+	fork.ParentID = &parentID
+	ctx := newHTTPContext(map[string]string{"id": parentID.Hex()}, `{"name": "my-tools", "organization": "acme"}`)
+
+	serviceMock.On("Fork", mock.Anything, parentID.Hex(), &model.RepoFork{Name: "my-tools", Organization: "acme"}).Return(fork, nil)
+
+	handler.NewForkHandler(serviceMock).Fork(ctx)
+
+	assert.Equal(t, http.StatusCreated, ctx.StatusCode)
+	assert.Equal(t, fork.ToPublicRepoModel(), ctx.Response)
+	assert.Equal(t, &parentID, ctx.Response.(*public_repo.PublicRepoModel).ParentID)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestFork_Error_NotFound(t *testing.T) {
+	serviceMock := new(ForkServiceMock)
+	parentID := primitive.NewObjectID()
+	ctx := newHTTPContext(map[string]string{"id": parentID.Hex()}, `{}`)
+
+	serviceMock.On("Fork", mock.Anything, parentID.Hex(), &model.RepoFork{}).Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
+
+	handler.NewForkHandler(serviceMock).Fork(ctx)
+
+	assert.Equal(t, http.StatusNotFound, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestListForks_Success(t *testing.T) {
+	serviceMock := new(ForkServiceMock)
+	parentID := primitive.NewObjectID()
+	fork := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": parentID.Hex()}, "")
+	ctx.query = map[string]string{"sort": "name", "limit": "10"}
+
+	serviceMock.On("ListForks", mock.Anything, parentID.Hex(), &model.RepoListQuery{SortBy: model.SortByName, Limit: 10}).
+		Return(&model.RepoPage{Repos: []*model.PrivateRepoModel{fork}, NextCursor: "next"}, nil)
+
+	handler.NewForkHandler(serviceMock).ListForks(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+	response := ctx.Response.(*public_repo.RepoListModel)
+	assert.Equal(t, "next", response.NextCursor)
+	assert.Equal(t, []interface{}{fork.ToPublicRepoModel()}, response.Repos)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestListNetwork_Error_NotFound(t *testing.T) {
+	serviceMock := new(ForkServiceMock)
+	repoID := primitive.NewObjectID()
+	ctx := newHTTPContext(map[string]string{"id": repoID.Hex()}, "")
+
+	serviceMock.On("ListNetwork", mock.Anything, repoID.Hex(), &model.RepoListQuery{}).Return((*model.RepoPage)(nil), repoerr.NotFound("repo not found"))
+
+	handler.NewForkHandler(serviceMock).ListNetwork(ctx)
+
+	assert.Equal(t, http.StatusNotFound, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
@@ -0,0 +1,342 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/router"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/server"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+)
+
+type RepoHandler struct {
+	Service service.RepoService
+}
+
+func NewRepoHandler(service service.RepoService) *RepoHandler {
+	return &RepoHandler{
+		Service: service,
+	}
+}
+
+func (h *RepoHandler) Register(r router.Router) {
+	r.GET("/repos", h.List)
+	r.POST("/repos", h.Create)
+	r.POST("/repos/validate-name", h.ValidateName)
+	r.GET("/repos/:identifier", h.Get)
+	r.GET("/repos/:owner/:name", h.GetByFullName)
+	r.PUT("/repos/:id", h.Update)
+	r.PATCH("/repos/:id", h.Patch)
+	r.PUT("/repos/:id/visibility", h.SetVisibility)
+	r.POST("/repos/:id/rename", h.Rename)
+	r.POST("/repos/:id/transfer", h.Transfer)
+	r.POST("/repos/:id/archive", h.Archive)
+	r.POST("/repos/:id/unarchive", h.Unarchive)
+	r.DELETE("/repos/:id", h.Delete)
+	r.POST("/repos/:id/restore", h.Restore)
+}
+
+func (h *RepoHandler) Create(ctx server.HTTPContext) {
+	body := &public_repo.CreateRepoModel{}
+	if err := ctx.BindJSON(body); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	repo, err := h.Service.Create(ctx.Context(), body)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	ctx.SetHeader("ETag", etag(repo))
+	ctx.JSON(http.StatusCreated, repo)
+}
+
+// ValidateName checks a name without creating a repo, so the UI can give feedback
+// while the user types. Broken rules are reported in a 200 response.
+func (h *RepoHandler) ValidateName(ctx server.HTTPContext) {
+	body := &public_repo.ValidateNameModel{}
+	if err := ctx.BindJSON(body); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	name, err := h.Service.ValidateName(ctx.Context(), body.Name)
+	if err != nil && !errors.Is(err, repoerr.ErrValidationFailed) {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	response := &public_repo.NameValidationModel{Name: name, Valid: err == nil}
+	for _, violation := range repoerr.ViolationsOf(err) {
+		response.Fields = append(response.Fields, public_repo.FieldErrorModel{
+			Field:   violation.Field,
+			Message: violation.Message,
+		})
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *RepoHandler) Get(ctx server.HTTPContext) {
+	repo, err := h.Service.FindByFindByIdentifier(ctx.Context(), ctx.GetParam("identifier"))
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeFound(ctx, repo)
+}
+
+func (h *RepoHandler) GetByFullName(ctx server.HTTPContext) {
+	repo, err := h.Service.FindByFullName(ctx.Context(), ctx.GetParam("owner")+"/"+ctx.GetParam("name"))
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeFound(ctx, repo)
+}
+
+// Update replaces the mutable fields of a repo. An If-Match header holding the ETag
+// of a previous read makes the update fail with 412 if the repo changed since.
+func (h *RepoHandler) Update(ctx server.HTTPContext) {
+	body := &public_repo.UpdateRepoModel{}
+	if err := ctx.BindJSON(body); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	repo, err := h.Service.FindById(ctx.Context(), ctx.GetParam("id"))
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	ifMatch := ctx.GetHeader("If-Match")
+	if ifMatch != "" && ifMatch != "*" {
+		version, ok := parseETag(ifMatch)
+		if !ok || version != repo.Version {
+			writeError(ctx, http.StatusPreconditionFailed, "repo was modified, reload it and retry")
+			return
+		}
+	}
+
+	repo.Name = body.Name
+	repo.Description = body.Description
+
+	updated, err := h.Service.Update(ctx.Context(), repo)
+	if err != nil {
+		if ifMatch != "" && errors.Is(err, repoerr.ErrVersionMismatch) {
+			writeError(ctx, http.StatusPreconditionFailed, "repo was modified, reload it and retry")
+			return
+		}
+
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeRepo(ctx, http.StatusOK, updated)
+}
+
+// Patch applies an RFC 7396 merge patch to a repo, only the members present in the
+// body are changed. If-Match makes the patch conditional like for Update.
+func (h *RepoHandler) Patch(ctx server.HTTPContext) {
+	document := map[string]json.RawMessage{}
+	if err := ctx.BindJSON(&document); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	patch, err := parseMergePatch(document)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	ifMatch := ctx.GetHeader("If-Match")
+	if ifMatch != "" && ifMatch != "*" {
+		version, ok := parseETag(ifMatch)
+		if !ok {
+			writeError(ctx, http.StatusPreconditionFailed, "repo was modified, reload it and retry")
+			return
+		}
+		patch.Version = version
+	}
+
+	updated, err := h.Service.Patch(ctx.Context(), ctx.GetParam("id"), patch)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrVersionMismatch) {
+			writeError(ctx, http.StatusPreconditionFailed, "repo was modified, reload it and retry")
+			return
+		}
+
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeRepo(ctx, http.StatusOK, updated)
+}
+
+func (h *RepoHandler) SetVisibility(ctx server.HTTPContext) {
+	body := &public_repo.VisibilityModel{}
+	if err := ctx.BindJSON(body); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	repo, err := h.Service.SetVisibility(ctx.Context(), ctx.GetParam("id"), body.Visibility)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeRepo(ctx, http.StatusOK, repo)
+}
+
+// Rename gives a repo a new name, the old one answers with a redirect from then on
+func (h *RepoHandler) Rename(ctx server.HTTPContext) {
+	body := &public_repo.RenameRepoModel{}
+	if err := ctx.BindJSON(body); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	repo, err := h.Service.Rename(ctx.Context(), ctx.GetParam("id"), body.Name, body.ReuseName)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeRepo(ctx, http.StatusOK, repo)
+}
+
+// Transfer moves a repo to another owner, its old "owner/name" keeps resolving to it
+func (h *RepoHandler) Transfer(ctx server.HTTPContext) {
+	body := &public_repo.TransferRepoModel{}
+	if err := ctx.BindJSON(body); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	repo, err := h.Service.Transfer(ctx.Context(), ctx.GetParam("id"), &model.RepoTransfer{
+		NewOwner:          body.NewOwner,
+		NewName:           body.NewName,
+		KeepCollaborators: body.KeepCollaborators,
+		ReuseName:         body.ReuseName,
+	})
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeRepo(ctx, http.StatusOK, repo)
+}
+
+// Archive makes a repo read-only, changes to it fail with 409 until it is unarchived
+func (h *RepoHandler) Archive(ctx server.HTTPContext) {
+	repo, err := h.Service.Archive(ctx.Context(), ctx.GetParam("id"))
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeRepo(ctx, http.StatusOK, repo)
+}
+
+func (h *RepoHandler) Unarchive(ctx server.HTTPContext) {
+	repo, err := h.Service.Unarchive(ctx.Context(), ctx.GetParam("id"))
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeRepo(ctx, http.StatusOK, repo)
+}
+
+func (h *RepoHandler) Delete(ctx server.HTTPContext) {
+	repo, err := h.Service.FindById(ctx.Context(), ctx.GetParam("id"))
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	if err := h.Service.Delete(ctx.Context(), repo); err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// Restore takes a deleted repo back out of the trash before it is purged
+func (h *RepoHandler) Restore(ctx server.HTTPContext) {
+	repo, err := h.Service.Restore(ctx.Context(), ctx.GetParam("id"))
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writeRepo(ctx, http.StatusOK, repo)
+}
+
+func (h *RepoHandler) List(ctx server.HTTPContext) {
+	query, err := parseListQuery(ctx)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	page, err := h.Service.List(ctx.Context(), query)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writePage(ctx, page)
+}
+
+func writePage(ctx server.HTTPContext, page *model.RepoPage) {
+	response := &public_repo.RepoListModel{
+		Repos:      make([]interface{}, 0, len(page.Repos)),
+		NextCursor: page.NextCursor,
+	}
+	for _, repo := range page.Repos {
+		response.Repos = append(response.Repos, repoView(ctx, repo))
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// writeFound answers a lookup by a former name with a 301 to the repo's id along
+// with the repo itself
+func writeFound(ctx server.HTTPContext, repo *model.PrivateRepoModel) {
+	if repo.RedirectedFrom == "" {
+		writeRepo(ctx, http.StatusOK, repo)
+		return
+	}
+
+	ctx.SetHeader("Location", "/repos/"+repo.ID.Hex())
+	writeRepo(ctx, http.StatusMovedPermanently, repo)
+}
+
+func writeRepo(ctx server.HTTPContext, code int, repo *model.PrivateRepoModel) {
+	ctx.SetHeader("ETag", etag(repo))
+	ctx.JSON(code, repoView(ctx, repo))
+}
+
+// repoView only exposes the private model to the repo's owner
+func repoView(ctx server.HTTPContext, repo *model.PrivateRepoModel) interface{} {
+	caller, ok := identity.FromContext(ctx.Context())
+	if ok && caller.ID == repo.OwnerID {
+		return repo
+	}
+
+	return repo.ToPublicRepoModel()
+}
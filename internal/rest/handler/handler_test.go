@@ -0,0 +1,818 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/handler"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/validation"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type HTTPContextMock struct {
+	ctx     context.Context
+	params  map[string]string
+	query   map[string]string
+	headers map[string]string
+	body    string
+
+	StatusCode      int
+	Response        interface{}
+	ResponseHeaders map[string]string
+}
+
+func newHTTPContext(params map[string]string, body string) *HTTPContextMock {
+	return &HTTPContextMock{ctx: context.TODO(), params: params, body: body}
+}
+
+func (c *HTTPContextMock) Context() context.Context {
+	return c.ctx
+}
+
+func (c *HTTPContextMock) GetParam(key string) string {
+	return c.params[key]
+}
+
+func (c *HTTPContextMock) GetQuery(key string) string {
+	return c.query[key]
+}
+
+func (c *HTTPContextMock) GetHeader(key string) string {
+	return c.headers[key]
+}
+
+func (c *HTTPContextMock) SetHeader(key string, value string) {
+	if c.ResponseHeaders == nil {
+		c.ResponseHeaders = map[string]string{}
+	}
+	c.ResponseHeaders[key] = value
+}
+
+func (c *HTTPContextMock) BindJSON(obj interface{}) error {
+	if err := json.Unmarshal([]byte(c.body), obj); err != nil {
+		return err
+	}
+
+	return validation.Struct(obj)
+}
+
+func (c *HTTPContextMock) JSON(code int, obj interface{}) {
+	c.StatusCode = code
+	c.Response = obj
+}
+
+func (c *HTTPContextMock) Status(code int) {
+	c.StatusCode = code
+}
+
+type RepoServiceMock struct {
+	mock.Mock
+}
+
+func (s *RepoServiceMock) Create(ctx context.Context, repo *public_repo.CreateRepoModel) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, repo)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) FindById(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) FindByName(ctx context.Context, name string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, name)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) FindByFullName(ctx context.Context, fullName string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, fullName)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) FindByFindByIdentifier(ctx context.Context, identifier string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, identifier)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Update(ctx context.Context, repo *model.PrivateRepoModel) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, repo)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Patch(ctx context.Context, id string, patch *model.RepoPatch) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id, patch)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) ValidateName(ctx context.Context, name string) (string, error) {
+	args := s.Called(ctx, name)
+	return args.String(0), args.Error(1)
+}
+
+func (s *RepoServiceMock) SetVisibility(ctx context.Context, id string, visibility string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id, visibility)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Rename(ctx context.Context, id string, name string, reuseName bool) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id, name, reuseName)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Transfer(ctx context.Context, id string, transfer *model.RepoTransfer) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id, transfer)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Delete(ctx context.Context, repo *model.PrivateRepoModel) error {
+	args := s.Called(ctx, repo)
+	return args.Error(0)
+}
+
+func (s *RepoServiceMock) Restore(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Archive(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) Unarchive(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	args := s.Called(ctx, id)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (s *RepoServiceMock) List(ctx context.Context, query *model.RepoListQuery) (*model.RepoPage, error) {
+	args := s.Called(ctx, query)
+	return args.Get(0).(*model.RepoPage), args.Error(1)
+}
+
+func newRepo(ownerID string) *model.PrivateRepoModel {
+	return &model.PrivateRepoModel{
+		ID:          primitive.NewObjectID(),
+		Name:        "test",
+		OwnerID:     ownerID,
+		Description: "test",
+		Version:     1,
+	}
+}
+
+func TestCreate_Created(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(nil, `{"name": "Test", "description": "Test"}`)
+
+	created := newRepo(primitive.NewObjectID().Hex())
+	serviceMock.On("Create", mock.Anything, mock.Anything).Return(created, nil)
+
+	handler.NewRepoHandler(serviceMock).Create(ctx)
+
+	assert.Equal(t, http.StatusCreated, ctx.StatusCode)
+	assert.Equal(t, created, ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCreate_Error_InvalidBody(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(nil, `{"name": `)
+
+	handler.NewRepoHandler(serviceMock).Create(ctx)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCreate_Error_MissingName(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(nil, `{"description": "Test"}`)
+
+	handler.NewRepoHandler(serviceMock).Create(ctx)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCreate_Error_Duplicate(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(nil, `{"name": "Test"}`)
+
+	duplicate := repoerr.Conflict("repo already exists")
+	serviceMock.On("Create", mock.Anything, mock.Anything).Return((*model.PrivateRepoModel)(nil), duplicate)
+
+	handler.NewRepoHandler(serviceMock).Create(ctx)
+
+	assert.Equal(t, http.StatusConflict, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGet_Public(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(map[string]string{"identifier": "test"}, "")
+
+	repo := newRepo(primitive.NewObjectID().Hex())
+	serviceMock.On("FindByFindByIdentifier", mock.Anything, "test").Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).Get(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+	assert.Equal(t, repo.ToPublicRepoModel(), ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGet_Owner(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ownerID := primitive.NewObjectID().Hex()
+	ctx := newHTTPContext(map[string]string{"identifier": "test"}, "")
+	ctx.ctx = identity.NewContext(ctx.ctx, identity.Caller{ID: ownerID})
+
+	repo := newRepo(ownerID)
+	serviceMock.On("FindByFindByIdentifier", mock.Anything, "test").Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).Get(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+	assert.Equal(t, repo, ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGetByFullName_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"owner": repo.OwnerID, "name": "test"}, "")
+
+	serviceMock.On("FindByFullName", mock.Anything, repo.OwnerID+"/test").Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).GetByFullName(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGet_Error_NotFound(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(map[string]string{"identifier": "missing"}, "")
+
+	serviceMock.On("FindByFindByIdentifier", mock.Anything, "missing").Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
+
+	handler.NewRepoHandler(serviceMock).Get(ctx)
+
+	assert.Equal(t, http.StatusNotFound, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGet_Error_Internal(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(map[string]string{"identifier": "test"}, "")
+
+	serviceMock.On("FindByFindByIdentifier", mock.Anything, "test").Return((*model.PrivateRepoModel)(nil), errors.New("boom"))
+
+	handler.NewRepoHandler(serviceMock).Get(ctx)
+
+	assert.Equal(t, http.StatusInternalServerError, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUpdate_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, `{"name": "renamed", "description": "new"}`)
+
+	serviceMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+	serviceMock.On("Update", mock.Anything, mock.MatchedBy(func(updated *model.PrivateRepoModel) bool {
+		return updated.Name == "renamed" && updated.Description == "new"
+	})).Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).Update(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUpdate_Error_NotFound(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(map[string]string{"id": "invalid"}, `{"name": "renamed"}`)
+
+	serviceMock.On("FindById", mock.Anything, "invalid").Return((*model.PrivateRepoModel)(nil), repoerr.New(repoerr.ErrInvalidID, "invalid repo id"))
+
+	handler.NewRepoHandler(serviceMock).Update(ctx)
+
+	assert.Equal(t, http.StatusNotFound, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestDelete_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, "")
+
+	serviceMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+	serviceMock.On("Delete", mock.Anything, repo).Return(nil)
+
+	handler.NewRepoHandler(serviceMock).Delete(ctx)
+
+	assert.Equal(t, http.StatusNoContent, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestDelete_Error_NotFound(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	id := primitive.NewObjectID().Hex()
+	ctx := newHTTPContext(map[string]string{"id": id}, "")
+
+	serviceMock.On("FindById", mock.Anything, id).Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
+
+	handler.NewRepoHandler(serviceMock).Delete(ctx)
+
+	assert.Equal(t, http.StatusNotFound, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRestore_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, "")
+
+	serviceMock.On("Restore", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).Restore(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRestore_Error_NotFound(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	id := primitive.NewObjectID().Hex()
+	ctx := newHTTPContext(map[string]string{"id": id}, "")
+
+	serviceMock.On("Restore", mock.Anything, id).Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
+
+	handler.NewRepoHandler(serviceMock).Restore(ctx)
+
+	assert.Equal(t, http.StatusNotFound, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestArchive_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	archivedAt := time.Now()
+	repo.ArchivedAt = &archivedAt
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, "")
+
+	serviceMock.On("Archive", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).Archive(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+	assert.True(t, ctx.Response.(*public_repo.PublicRepoModel).Archived)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUnarchive_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, "")
+
+	serviceMock.On("Unarchive", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).Unarchive(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestPatch_Error_Archived(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, `{"description": "new"}`)
+
+	serviceMock.On("Patch", mock.Anything, repo.ID.Hex(), mock.Anything).Return((*model.PrivateRepoModel)(nil), repoerr.ReadOnly("repo is archived and read-only, unarchive it first"))
+
+	handler.NewRepoHandler(serviceMock).Patch(ctx)
+
+	assert.Equal(t, http.StatusConflict, ctx.StatusCode)
+	assert.Equal(t, "repo is archived and read-only, unarchive it first", ctx.Response.(*public_repo.ErrorModel).Error)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCreate_Error_Validation(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(nil, `{"name": "Test"}`)
+
+	serviceMock.On("Create", mock.Anything, mock.Anything).Return((*model.PrivateRepoModel)(nil), repoerr.Validation(repoerr.Violation{Field: "name", Message: "is too long"}))
+
+	handler.NewRepoHandler(serviceMock).Create(ctx)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, ctx.StatusCode)
+	assert.Equal(t, []public_repo.FieldErrorModel{{Field: "name", Message: "is too long"}}, ctx.Response.(*public_repo.ErrorModel).Fields)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGet_Error_Unavailable(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(map[string]string{"identifier": "test"}, "")
+
+	serviceMock.On("FindByFindByIdentifier", mock.Anything, "test").Return((*model.PrivateRepoModel)(nil), repoerr.Wrap(repoerr.ErrUnavailable, errors.New("connection reset"), "repository unavailable"))
+
+	handler.NewRepoHandler(serviceMock).Get(ctx)
+
+	assert.Equal(t, http.StatusServiceUnavailable, ctx.StatusCode)
+	assert.Equal(t, "repository unavailable", ctx.Response.(*public_repo.ErrorModel).Error)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestList_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ownerID := primitive.NewObjectID().Hex()
+	ctx := newHTTPContext(nil, "")
+	ctx.query = map[string]string{"owner": ownerID, "sort": "-name", "limit": "2", "created_after": "2023-01-02T15:04:05Z", "archived": "false"}
+
+	repo := newRepo(ownerID)
+	archived := false
+	serviceMock.On("List", mock.Anything, &model.RepoListQuery{
+		OwnerID:      ownerID,
+		SortBy:       model.SortByName,
+		Descending:   true,
+		Limit:        2,
+		CreatedAfter: time.Date(2023, 1, 2, 15, 4, 5, 0, time.UTC),
+		Archived:     &archived,
+	}).Return(&model.RepoPage{Repos: []*model.PrivateRepoModel{repo}, NextCursor: "next"}, nil)
+
+	handler.NewRepoHandler(serviceMock).List(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+	response := ctx.Response.(*public_repo.RepoListModel)
+	assert.Equal(t, "next", response.NextCursor)
+	assert.Equal(t, []interface{}{repo.ToPublicRepoModel()}, response.Repos)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestList_Error_InvalidParams(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(nil, "")
+	ctx.query = map[string]string{"limit": "many", "updated_before": "yesterday", "archived": "maybe"}
+
+	handler.NewRepoHandler(serviceMock).List(ctx)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, ctx.StatusCode)
+	assert.Len(t, ctx.Response.(*public_repo.ErrorModel).Fields, 3)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGet_ETag(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(map[string]string{"identifier": "test"}, "")
+
+	repo := newRepo(primitive.NewObjectID().Hex())
+	repo.Version = 7
+	serviceMock.On("FindByFindByIdentifier", mock.Anything, "test").Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).Get(ctx)
+
+	assert.Equal(t, `"7"`, ctx.ResponseHeaders["ETag"])
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUpdate_IfMatch_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, `{"name": "renamed"}`)
+	ctx.headers = map[string]string{"If-Match": `W/"1"`}
+
+	updated := *repo
+	updated.Version = 2
+	serviceMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+	serviceMock.On("Update", mock.Anything, mock.Anything).Return(&updated, nil)
+
+	handler.NewRepoHandler(serviceMock).Update(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+	assert.Equal(t, `"2"`, ctx.ResponseHeaders["ETag"])
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUpdate_IfMatch_Stale(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	repo.Version = 2
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, `{"name": "renamed"}`)
+	ctx.headers = map[string]string{"If-Match": `"1"`}
+
+	serviceMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).Update(ctx)
+
+	assert.Equal(t, http.StatusPreconditionFailed, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUpdate_IfMatch_LostRace(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, `{"name": "renamed"}`)
+	ctx.headers = map[string]string{"If-Match": `"1"`}
+
+	serviceMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+	serviceMock.On("Update", mock.Anything, mock.Anything).Return((*model.PrivateRepoModel)(nil), repoerr.VersionMismatch())
+
+	handler.NewRepoHandler(serviceMock).Update(ctx)
+
+	assert.Equal(t, http.StatusPreconditionFailed, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUpdate_Error_Conflict(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, `{"name": "renamed"}`)
+
+	serviceMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+	serviceMock.On("Update", mock.Anything, mock.Anything).Return((*model.PrivateRepoModel)(nil), repoerr.VersionMismatch())
+
+	handler.NewRepoHandler(serviceMock).Update(ctx)
+
+	assert.Equal(t, http.StatusConflict, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestPatch_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, `{"description": null}`)
+
+	serviceMock.On("Patch", mock.Anything, repo.ID.Hex(), mock.MatchedBy(func(patch *model.RepoPatch) bool {
+		return patch.Name == nil && *patch.Description == "" && patch.Version == 0
+	})).Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).Patch(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestPatch_IfMatch(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, `{"name": "renamed"}`)
+	ctx.headers = map[string]string{"If-Match": `"3"`}
+
+	serviceMock.On("Patch", mock.Anything, repo.ID.Hex(), mock.MatchedBy(func(patch *model.RepoPatch) bool {
+		return patch.Version == 3
+	})).Return((*model.PrivateRepoModel)(nil), repoerr.VersionMismatch())
+
+	handler.NewRepoHandler(serviceMock).Patch(ctx)
+
+	assert.Equal(t, http.StatusPreconditionFailed, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestPatch_TemplateFields(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, `{"isTemplate": true, "topics": ["go", "grpc"], "properties": null}`)
+
+	isTemplate := true
+	serviceMock.On("Patch", mock.Anything, repo.ID.Hex(), &model.RepoPatch{
+		IsTemplate: &isTemplate,
+		Topics:     &[]string{"go", "grpc"},
+		Properties: &map[string]string{},
+	}).Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).Patch(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestPatch_Error_TemplateFieldTypes(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(map[string]string{"id": primitive.NewObjectID().Hex()}, `{"isTemplate": null, "topics": "go", "properties": {"team": 1}}`)
+
+	handler.NewRepoHandler(serviceMock).Patch(ctx)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, ctx.StatusCode)
+	assert.Len(t, ctx.Response.(*public_repo.ErrorModel).Fields, 3)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestPatch_Error_ImmutableField(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(map[string]string{"id": primitive.NewObjectID().Hex()}, `{"ownerId": "someone", "created_at": null}`)
+
+	handler.NewRepoHandler(serviceMock).Patch(ctx)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, ctx.StatusCode)
+	assert.Len(t, ctx.Response.(*public_repo.ErrorModel).Fields, 2)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestPatch_Error_NotAnObject(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(map[string]string{"id": primitive.NewObjectID().Hex()}, `["name"]`)
+
+	handler.NewRepoHandler(serviceMock).Patch(ctx)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestValidateName_Valid(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(nil, `{"name": "My Repo"}`)
+
+	serviceMock.On("ValidateName", mock.Anything, "My Repo").Return("my-repo", nil)
+
+	handler.NewRepoHandler(serviceMock).ValidateName(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+	assert.Equal(t, &public_repo.NameValidationModel{Name: "my-repo", Valid: true}, ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestValidateName_Invalid(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(nil, `{"name": "new"}`)
+
+	serviceMock.On("ValidateName", mock.Anything, "new").Return("new", repoerr.Validation(repoerr.Violation{Field: "name", Message: "is reserved"}))
+
+	handler.NewRepoHandler(serviceMock).ValidateName(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+	assert.Equal(t, &public_repo.NameValidationModel{
+		Name:   "new",
+		Fields: []public_repo.FieldErrorModel{{Field: "name", Message: "is reserved"}},
+	}, ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCreate_Error_BindingTags(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(nil, `{"name": "   ", "description": "test"}`)
+
+	handler.NewRepoHandler(serviceMock).Create(ctx)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, ctx.StatusCode)
+	assert.Equal(t, []public_repo.FieldErrorModel{{Field: "name", Message: "is required"}}, ctx.Response.(*public_repo.ErrorModel).Fields)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestSetVisibility_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	repo.Visibility = model.VisibilityPrivate
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, `{"visibility": "private"}`)
+
+	serviceMock.On("SetVisibility", mock.Anything, repo.ID.Hex(), "private").Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).SetVisibility(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestSetVisibility_Error_Invalid(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(map[string]string{"id": primitive.NewObjectID().Hex()}, `{"visibility": "secret"}`)
+
+	handler.NewRepoHandler(serviceMock).SetVisibility(ctx)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, ctx.StatusCode)
+	assert.Equal(t, "visibility", ctx.Response.(*public_repo.ErrorModel).Fields[0].Field)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGet_MovedPermanently(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	repo.RedirectedFrom = "old-name"
+	ctx := newHTTPContext(map[string]string{"identifier": "old-name"}, "")
+
+	serviceMock.On("FindByFindByIdentifier", mock.Anything, "old-name").Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).Get(ctx)
+
+	assert.Equal(t, http.StatusMovedPermanently, ctx.StatusCode)
+	assert.Equal(t, "/repos/"+repo.ID.Hex(), ctx.ResponseHeaders["Location"])
+	assert.Equal(t, repo.ToPublicRepoModel(), ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRename_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, `{"name": "renamed", "reuseName": true}`)
+
+	serviceMock.On("Rename", mock.Anything, repo.ID.Hex(), "renamed", true).Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).Rename(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRename_Error_MissingName(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(map[string]string{"id": primitive.NewObjectID().Hex()}, `{}`)
+
+	handler.NewRepoHandler(serviceMock).Rename(ctx)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, ctx.StatusCode)
+	assert.Equal(t, "name", ctx.Response.(*public_repo.ErrorModel).Fields[0].Field)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTransfer_Success(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	ctx := newHTTPContext(map[string]string{"id": repo.ID.Hex()}, `{"newOwner": "acme", "newName": "tools", "keepCollaborators": true, "reuseName": true}`)
+
+	serviceMock.On("Transfer", mock.Anything, repo.ID.Hex(), &model.RepoTransfer{NewOwner: "acme", NewName: "tools", KeepCollaborators: true, ReuseName: true}).Return(repo, nil)
+
+	handler.NewRepoHandler(serviceMock).Transfer(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestTransfer_Error_Conflict(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	id := primitive.NewObjectID().Hex()
+	ctx := newHTTPContext(map[string]string{"id": id}, `{"newOwner": "acme"}`)
+
+	serviceMock.On("Transfer", mock.Anything, id, &model.RepoTransfer{NewOwner: "acme"}).Return((*model.PrivateRepoModel)(nil), repoerr.Conflict(`acme already has a repo named "test"`))
+
+	handler.NewRepoHandler(serviceMock).Transfer(ctx)
+
+	assert.Equal(t, http.StatusConflict, ctx.StatusCode)
+	assert.Equal(t, &public_repo.ErrorModel{Error: `acme already has a repo named "test"`}, ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestCreate_Error_Unauthenticated(t *testing.T) {
+	serviceMock := new(RepoServiceMock)
+	ctx := newHTTPContext(nil, `{"name": "test"}`)
+
+	serviceMock.On("Create", mock.Anything, mock.Anything).Return((*model.PrivateRepoModel)(nil), repoerr.Unauthenticated("sign in to create a repo"))
+
+	handler.NewRepoHandler(serviceMock).Create(ctx)
+
+	assert.Equal(t, http.StatusUnauthorized, ctx.StatusCode)
+	assert.Equal(t, &public_repo.ErrorModel{Error: "sign in to create a repo"}, ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
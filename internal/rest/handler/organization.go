@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/router"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/server"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+)
+
+type OrganizationHandler struct {
+	Service service.OrganizationService
+}
+
+func NewOrganizationHandler(service service.OrganizationService) *OrganizationHandler {
+	return &OrganizationHandler{
+		Service: service,
+	}
+}
+
+func (h *OrganizationHandler) Register(r router.Router) {
+	r.POST("/orgs", h.Create)
+	r.GET("/orgs/:org", h.Get)
+	r.PUT("/orgs/:org/members/:user", h.SetMember)
+	r.DELETE("/orgs/:org/members/:user", h.RemoveMember)
+	r.GET("/orgs/:org/teams", h.ListTeams)
+	r.POST("/orgs/:org/teams", h.CreateTeam)
+	r.PUT("/orgs/:org/teams/:team/members/:user", h.AddTeamMember)
+	r.DELETE("/orgs/:org/teams/:team/members/:user", h.RemoveTeamMember)
+	r.PUT("/orgs/:org/teams/:team/repos/:id", h.SetTeamRepo)
+	r.DELETE("/orgs/:org/teams/:team/repos/:id", h.RemoveTeamRepo)
+}
+
+func (h *OrganizationHandler) Create(ctx server.HTTPContext) {
+	body := &public_repo.CreateOrganizationModel{}
+	if err := ctx.BindJSON(body); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	org, err := h.Service.CreateOrganization(ctx.Context(), body.Login, body.Name)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, org.ToPublicOrganizationModel())
+}
+
+func (h *OrganizationHandler) Get(ctx server.HTTPContext) {
+	org, err := h.Service.FindOrganization(ctx.Context(), ctx.GetParam("org"))
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, org.ToPublicOrganizationModel())
+}
+
+func (h *OrganizationHandler) SetMember(ctx server.HTTPContext) {
+	body := &public_repo.OrgMemberRoleModel{}
+	if err := ctx.BindJSON(body); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	org, err := h.Service.SetOrgMember(ctx.Context(), ctx.GetParam("org"), ctx.GetParam("user"), body.Role)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, org.ToPublicOrganizationModel())
+}
+
+func (h *OrganizationHandler) RemoveMember(ctx server.HTTPContext) {
+	if err := h.Service.RemoveOrgMember(ctx.Context(), ctx.GetParam("org"), ctx.GetParam("user")); err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *OrganizationHandler) ListTeams(ctx server.HTTPContext) {
+	teams, err := h.Service.ListTeams(ctx.Context(), ctx.GetParam("org"))
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	response := &public_repo.TeamListModel{Teams: make([]public_repo.TeamModel, 0, len(teams))}
+	for _, team := range teams {
+		response.Teams = append(response.Teams, team.ToPublicTeamModel())
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+func (h *OrganizationHandler) CreateTeam(ctx server.HTTPContext) {
+	body := &public_repo.CreateTeamModel{}
+	if err := ctx.BindJSON(body); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	team, err := h.Service.CreateTeam(ctx.Context(), ctx.GetParam("org"), body.Slug, body.Name)
+	writeTeam(ctx, http.StatusCreated, team, err)
+}
+
+func (h *OrganizationHandler) AddTeamMember(ctx server.HTTPContext) {
+	team, err := h.Service.AddTeamMember(ctx.Context(), ctx.GetParam("org"), ctx.GetParam("team"), ctx.GetParam("user"))
+	writeTeam(ctx, http.StatusOK, team, err)
+}
+
+func (h *OrganizationHandler) RemoveTeamMember(ctx server.HTTPContext) {
+	team, err := h.Service.RemoveTeamMember(ctx.Context(), ctx.GetParam("org"), ctx.GetParam("team"), ctx.GetParam("user"))
+	writeTeam(ctx, http.StatusOK, team, err)
+}
+
+func (h *OrganizationHandler) SetTeamRepo(ctx server.HTTPContext) {
+	body := &public_repo.CollaboratorRoleModel{}
+	if err := ctx.BindJSON(body); err != nil {
+		writeBindError(ctx, err)
+		return
+	}
+
+	team, err := h.Service.SetTeamRepo(ctx.Context(), ctx.GetParam("org"), ctx.GetParam("team"), ctx.GetParam("id"), body.Role)
+	writeTeam(ctx, http.StatusOK, team, err)
+}
+
+func (h *OrganizationHandler) RemoveTeamRepo(ctx server.HTTPContext) {
+	team, err := h.Service.RemoveTeamRepo(ctx.Context(), ctx.GetParam("org"), ctx.GetParam("team"), ctx.GetParam("id"))
+	writeTeam(ctx, http.StatusOK, team, err)
+}
+
+func writeTeam(ctx server.HTTPContext, code int, team *model.Team, err error) {
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	ctx.JSON(code, team.ToPublicTeamModel())
+}
@@ -0,0 +1,139 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/handler"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type OrganizationServiceMock struct {
+	mock.Mock
+}
+
+func (s *OrganizationServiceMock) CreateOrganization(ctx context.Context, login string, name string) (*model.Organization, error) {
+	args := s.Called(ctx, login, name)
+	return args.Get(0).(*model.Organization), args.Error(1)
+}
+
+func (s *OrganizationServiceMock) FindOrganization(ctx context.Context, login string) (*model.Organization, error) {
+	args := s.Called(ctx, login)
+	return args.Get(0).(*model.Organization), args.Error(1)
+}
+
+func (s *OrganizationServiceMock) SetOrgMember(ctx context.Context, login string, userID string, role string) (*model.Organization, error) {
+	args := s.Called(ctx, login, userID, role)
+	return args.Get(0).(*model.Organization), args.Error(1)
+}
+
+func (s *OrganizationServiceMock) RemoveOrgMember(ctx context.Context, login string, userID string) error {
+	args := s.Called(ctx, login, userID)
+	return args.Error(0)
+}
+
+func (s *OrganizationServiceMock) ListTeams(ctx context.Context, login string) ([]*model.Team, error) {
+	args := s.Called(ctx, login)
+	return args.Get(0).([]*model.Team), args.Error(1)
+}
+
+func (s *OrganizationServiceMock) CreateTeam(ctx context.Context, login string, slug string, name string) (*model.Team, error) {
+	args := s.Called(ctx, login, slug, name)
+	return args.Get(0).(*model.Team), args.Error(1)
+}
+
+func (s *OrganizationServiceMock) AddTeamMember(ctx context.Context, login string, slug string, userID string) (*model.Team, error) {
+	args := s.Called(ctx, login, slug, userID)
+	return args.Get(0).(*model.Team), args.Error(1)
+}
+
+func (s *OrganizationServiceMock) RemoveTeamMember(ctx context.Context, login string, slug string, userID string) (*model.Team, error) {
+	args := s.Called(ctx, login, slug, userID)
+	return args.Get(0).(*model.Team), args.Error(1)
+}
+
+func (s *OrganizationServiceMock) SetTeamRepo(ctx context.Context, login string, slug string, repoID string, role string) (*model.Team, error) {
+	args := s.Called(ctx, login, slug, repoID, role)
+	return args.Get(0).(*model.Team), args.Error(1)
+}
+
+func (s *OrganizationServiceMock) RemoveTeamRepo(ctx context.Context, login string, slug string, repoID string) (*model.Team, error) {
+	args := s.Called(ctx, login, slug, repoID)
+	return args.Get(0).(*model.Team), args.Error(1)
+}
+
+func TestCreateOrganization_Created(t *testing.T) {
+	serviceMock := new(OrganizationServiceMock)
+	org := &model.Organization{ID: primitive.NewObjectID(), Login: "acme", Name: "Acme", Members: []model.OrgMember{{UserID: "owner", Role: model.OrgRoleOwner}}}
+	ctx := newHTTPContext(nil, `{"login": "acme", "name": "Acme"}`)
+
+	serviceMock.On("CreateOrganization", mock.Anything, "acme", "Acme").Return(org, nil)
+
+	handler.NewOrganizationHandler(serviceMock).Create(ctx)
+
+	assert.Equal(t, http.StatusCreated, ctx.StatusCode)
+	assert.Equal(t, org.ToPublicOrganizationModel(), ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestGetOrganization_Error_NotFound(t *testing.T) {
+	serviceMock := new(OrganizationServiceMock)
+	ctx := newHTTPContext(map[string]string{"org": "acme"}, "")
+
+	serviceMock.On("FindOrganization", mock.Anything, "acme").Return((*model.Organization)(nil), repoerr.NotFound("organization not found"))
+
+	handler.NewOrganizationHandler(serviceMock).Get(ctx)
+
+	assert.Equal(t, http.StatusNotFound, ctx.StatusCode)
+	assert.Equal(t, &public_repo.ErrorModel{Error: "organization not found"}, ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestSetOrgMember_Error_InvalidRole(t *testing.T) {
+	serviceMock := new(OrganizationServiceMock)
+	ctx := newHTTPContext(map[string]string{"org": "acme", "user": "user"}, `{"role": "admin"}`)
+
+	handler.NewOrganizationHandler(serviceMock).SetMember(ctx)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, ctx.StatusCode)
+	assert.Equal(t, "role", ctx.Response.(*public_repo.ErrorModel).Fields[0].Field)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestSetTeamRepo_Success(t *testing.T) {
+	serviceMock := new(OrganizationServiceMock)
+	repoID := primitive.NewObjectID()
+	team := &model.Team{ID: primitive.NewObjectID(), Slug: "core", Repos: []model.TeamRepo{{RepoID: repoID, Role: model.CollaboratorWrite}}}
+	ctx := newHTTPContext(map[string]string{"org": "acme", "team": "core", "id": repoID.Hex()}, `{"role": "write"}`)
+
+	serviceMock.On("SetTeamRepo", mock.Anything, "acme", "core", repoID.Hex(), "write").Return(team, nil)
+
+	handler.NewOrganizationHandler(serviceMock).SetTeamRepo(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+	assert.Equal(t, team.ToPublicTeamModel(), ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestRemoveOrgMember_Error_Conflict(t *testing.T) {
+	serviceMock := new(OrganizationServiceMock)
+	ctx := newHTTPContext(map[string]string{"org": "acme", "user": "owner"}, "")
+
+	serviceMock.On("RemoveOrgMember", mock.Anything, "acme", "owner").Return(repoerr.Conflict(`organization "acme" needs another owner first`))
+
+	handler.NewOrganizationHandler(serviceMock).RemoveMember(ctx)
+
+	assert.Equal(t, http.StatusConflict, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+)
+
+// immutableFields are the repo fields a patch may not touch
+var immutableFields = map[string]bool{
+	"id":         true,
+	"ownerId":    true,
+	"created_at": true,
+	"updated_at": true,
+	"version":    true,
+}
+
+// parseMergePatch turns an RFC 7396 merge patch into a RepoPatch. A null member
+// resets the field, which description, topics and properties allow. Properties are
+// replaced as a whole rather than merged member by member.
+func parseMergePatch(document map[string]json.RawMessage) (*model.RepoPatch, error) {
+	patch := &model.RepoPatch{}
+	violations := []repoerr.Violation{}
+
+	fields := make([]string, 0, len(document))
+	for field := range document {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		value := document[field]
+
+		switch {
+		case field == "name":
+			name, ok := patchString(value)
+			if !ok || name == nil {
+				violations = append(violations, repoerr.Violation{Field: field, Message: "must be a string"})
+				continue
+			}
+			patch.Name = name
+		case field == "description":
+			description, ok := patchString(value)
+			if !ok {
+				violations = append(violations, repoerr.Violation{Field: field, Message: "must be a string or null"})
+				continue
+			}
+			if description == nil {
+				description = new(string)
+			}
+			patch.Description = description
+		case field == "topics":
+			topics := []string{}
+			if string(value) != "null" && json.Unmarshal(value, &topics) != nil {
+				violations = append(violations, repoerr.Violation{Field: field, Message: "must be an array of strings or null"})
+				continue
+			}
+			patch.Topics = &topics
+		case field == "properties":
+			properties := map[string]string{}
+			if string(value) != "null" && json.Unmarshal(value, &properties) != nil {
+				violations = append(violations, repoerr.Violation{Field: field, Message: "must be an object of strings or null"})
+				continue
+			}
+			patch.Properties = &properties
+		case field == "isTemplate":
+			isTemplate := false
+			if err := json.Unmarshal(value, &isTemplate); err != nil || string(value) == "null" {
+				violations = append(violations, repoerr.Violation{Field: field, Message: "must be a boolean"})
+				continue
+			}
+			patch.IsTemplate = &isTemplate
+		case field == "visibility":
+			violations = append(violations, repoerr.Violation{Field: field, Message: "must be changed with PUT /repos/:id/visibility"})
+		case immutableFields[field]:
+			violations = append(violations, repoerr.Violation{Field: field, Message: "is immutable"})
+		default:
+			violations = append(violations, repoerr.Violation{Field: field, Message: "is not a known field"})
+		}
+	}
+
+	if len(violations) > 0 {
+		return nil, repoerr.Validation(violations...)
+	}
+
+	return patch, nil
+}
+
+// patchString decodes a string member, returning nil for a JSON null
+func patchString(value json.RawMessage) (*string, bool) {
+	if string(value) == "null" {
+		return nil, true
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return nil, false
+	}
+
+	return &s, true
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/server"
+)
+
+// parseListQuery reads GET /repos parameters; sort takes a key, prefixed with "-"
+// for descending order
+func parseListQuery(ctx server.HTTPContext) (*model.RepoListQuery, error) {
+	query := &model.RepoListQuery{
+		OwnerID:    ctx.GetQuery("owner"),
+		NamePrefix: ctx.GetQuery("name_prefix"),
+		Cursor:     ctx.GetQuery("cursor"),
+	}
+	violations := []repoerr.Violation{}
+
+	sort := ctx.GetQuery("sort")
+	query.Descending = strings.HasPrefix(sort, "-")
+	query.SortBy = strings.TrimPrefix(sort, "-")
+
+	if limit := ctx.GetQuery("limit"); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil {
+			violations = append(violations, repoerr.Violation{Field: "limit", Message: "must be a number"})
+		}
+		query.Limit = parsed
+	}
+
+	if archived := ctx.GetQuery("archived"); archived != "" {
+		parsed, err := strconv.ParseBool(archived)
+		if err != nil {
+			violations = append(violations, repoerr.Violation{Field: "archived", Message: "must be true or false"})
+		}
+		query.Archived = &parsed
+	}
+
+	for _, param := range []struct {
+		name   string
+		target *time.Time
+	}{
+		{"created_after", &query.CreatedAfter},
+		{"created_before", &query.CreatedBefore},
+		{"updated_after", &query.UpdatedAfter},
+		{"updated_before", &query.UpdatedBefore},
+	} {
+		value := ctx.GetQuery(param.name)
+		if value == "" {
+			continue
+		}
+
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			violations = append(violations, repoerr.Violation{Field: param.name, Message: "must be an RFC 3339 timestamp"})
+			continue
+		}
+		*param.target = parsed
+	}
+
+	if len(violations) > 0 {
+		return nil, repoerr.Validation(violations...)
+	}
+
+	return query, nil
+}
+
+// parseSubscriptionQuery reads the limit and cursor of a page of subscriptions
+func parseSubscriptionQuery(ctx server.HTTPContext) (*model.SubscriptionQuery, error) {
+	query := &model.SubscriptionQuery{Cursor: ctx.GetQuery("cursor")}
+
+	if limit := ctx.GetQuery("limit"); limit != "" {
+		parsed, err := strconv.Atoi(limit)
+		if err != nil {
+			return nil, repoerr.Validation(repoerr.Violation{Field: "limit", Message: "must be a number"})
+		}
+		query.Limit = parsed
+	}
+
+	return query, nil
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/router"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/server"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+)
+
+type SubscriptionHandler struct {
+	Service service.SubscriptionService
+}
+
+func NewSubscriptionHandler(service service.SubscriptionService) *SubscriptionHandler {
+	return &SubscriptionHandler{
+		Service: service,
+	}
+}
+
+// Register has to run before RepoHandler.Register, whose /repos/:owner/:name
+// would otherwise catch /repos/:id/stargazers and /repos/:id/watchers
+func (h *SubscriptionHandler) Register(r router.Router) {
+	r.PUT("/repos/:id/star", h.Star)
+	r.DELETE("/repos/:id/star", h.Unstar)
+	r.PUT("/repos/:id/watch", h.Watch)
+	r.DELETE("/repos/:id/watch", h.Unwatch)
+	r.GET("/repos/:id/stargazers", h.ListStargazers)
+	r.GET("/repos/:id/watchers", h.ListWatchers)
+	r.GET("/users/:user/starred", h.ListStarred)
+}
+
+func (h *SubscriptionHandler) Star(ctx server.HTTPContext) {
+	h.change(ctx, h.Service.Star)
+}
+
+func (h *SubscriptionHandler) Unstar(ctx server.HTTPContext) {
+	h.change(ctx, h.Service.Unstar)
+}
+
+func (h *SubscriptionHandler) Watch(ctx server.HTTPContext) {
+	h.change(ctx, h.Service.Watch)
+}
+
+func (h *SubscriptionHandler) Unwatch(ctx server.HTTPContext) {
+	h.change(ctx, h.Service.Unwatch)
+}
+
+func (h *SubscriptionHandler) ListStargazers(ctx server.HTTPContext) {
+	h.list(ctx, h.Service.ListStargazers)
+}
+
+func (h *SubscriptionHandler) ListWatchers(ctx server.HTTPContext) {
+	h.list(ctx, h.Service.ListWatchers)
+}
+
+func (h *SubscriptionHandler) ListStarred(ctx server.HTTPContext) {
+	query, err := parseSubscriptionQuery(ctx)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	page, err := h.Service.ListStarred(ctx.Context(), ctx.GetParam("user"), query)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	writePage(ctx, page)
+}
+
+func (h *SubscriptionHandler) change(ctx server.HTTPContext, change func(ctx context.Context, id string) error) {
+	if err := change(ctx.Context(), ctx.GetParam("id")); err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (h *SubscriptionHandler) list(ctx server.HTTPContext, list func(ctx context.Context, id string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error)) {
+	query, err := parseSubscriptionQuery(ctx)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	page, err := list(ctx.Context(), ctx.GetParam("id"), query)
+	if err != nil {
+		writeServiceError(ctx, err)
+		return
+	}
+
+	response := &public_repo.SubscriptionListModel{
+		Subscriptions: make([]public_repo.SubscriptionModel, 0, len(page.Subscriptions)),
+		NextCursor:    page.NextCursor,
+	}
+	for _, subscription := range page.Subscriptions {
+		response.Subscriptions = append(response.Subscriptions, subscription.ToPublicSubscriptionModel())
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
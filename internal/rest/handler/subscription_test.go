@@ -0,0 +1,136 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/rest/handler"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type SubscriptionServiceMock struct {
+	mock.Mock
+}
+
+func (s *SubscriptionServiceMock) Star(ctx context.Context, id string) error {
+	return s.Called(ctx, id).Error(0)
+}
+
+func (s *SubscriptionServiceMock) Unstar(ctx context.Context, id string) error {
+	return s.Called(ctx, id).Error(0)
+}
+
+func (s *SubscriptionServiceMock) Watch(ctx context.Context, id string) error {
+	return s.Called(ctx, id).Error(0)
+}
+
+func (s *SubscriptionServiceMock) Unwatch(ctx context.Context, id string) error {
+	return s.Called(ctx, id).Error(0)
+}
+
+func (s *SubscriptionServiceMock) ListStargazers(ctx context.Context, id string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error) {
+	args := s.Called(ctx, id, query)
+	return args.Get(0).(*model.SubscriptionPage), args.Error(1)
+}
+
+func (s *SubscriptionServiceMock) ListWatchers(ctx context.Context, id string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error) {
+	args := s.Called(ctx, id, query)
+	return args.Get(0).(*model.SubscriptionPage), args.Error(1)
+}
+
+func (s *SubscriptionServiceMock) ListStarred(ctx context.Context, userID string, query *model.SubscriptionQuery) (*model.RepoPage, error) {
+	args := s.Called(ctx, userID, query)
+	return args.Get(0).(*model.RepoPage), args.Error(1)
+}
+
+func TestStar_NoContent(t *testing.T) {
+	serviceMock := new(SubscriptionServiceMock)
+	repoID := primitive.NewObjectID()
+	ctx := newHTTPContext(map[string]string{"id": repoID.Hex()}, "")
+
+	serviceMock.On("Star", mock.Anything, repoID.Hex()).Return(nil)
+
+	handler.NewSubscriptionHandler(serviceMock).Star(ctx)
+
+	assert.Equal(t, http.StatusNoContent, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestUnwatch_Error_Unauthenticated(t *testing.T) {
+	serviceMock := new(SubscriptionServiceMock)
+	repoID := primitive.NewObjectID()
+	ctx := newHTTPContext(map[string]string{"id": repoID.Hex()}, "")
+
+	serviceMock.On("Unwatch", mock.Anything, repoID.Hex()).Return(repoerr.Unauthenticated("sign in to unwatch a repo"))
+
+	handler.NewSubscriptionHandler(serviceMock).Unwatch(ctx)
+
+	assert.Equal(t, http.StatusUnauthorized, ctx.StatusCode)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestListStargazers_Success(t *testing.T) {
+	serviceMock := new(SubscriptionServiceMock)
+	repoID := primitive.NewObjectID()
+	createdAt := time.Now()
+	ctx := newHTTPContext(map[string]string{"id": repoID.Hex()}, "")
+	ctx.query = map[string]string{"limit": "1", "cursor": "abc"}
+
+	serviceMock.On("ListStargazers", mock.Anything, repoID.Hex(), &model.SubscriptionQuery{Limit: 1, Cursor: "abc"}).
+		Return(&model.SubscriptionPage{
+			Subscriptions: []*model.Subscription{{RepoID: repoID, UserID: "user", Kind: model.SubscriptionStar, CreatedAt: createdAt}},
+			NextCursor:    "next",
+		}, nil)
+
+	handler.NewSubscriptionHandler(serviceMock).ListStargazers(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+	assert.Equal(t, &public_repo.SubscriptionListModel{
+		Subscriptions: []public_repo.SubscriptionModel{{UserID: "user", CreatedAt: createdAt}},
+		NextCursor:    "next",
+	}, ctx.Response)
+
+	serviceMock.AssertExpectations(t)
+}
+
+func TestListWatchers_Error_LimitNotANumber(t *testing.T) {
+	serviceMock := new(SubscriptionServiceMock)
+	ctx := newHTTPContext(map[string]string{"id": primitive.NewObjectID().Hex()}, "")
+	ctx.query = map[string]string{"limit": "many"}
+
+	handler.NewSubscriptionHandler(serviceMock).ListWatchers(ctx)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, ctx.StatusCode)
+
+	serviceMock.AssertNotCalled(t, "ListWatchers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestListStarred_Success(t *testing.T) {
+	serviceMock := new(SubscriptionServiceMock)
+	repo := newRepo(primitive.NewObjectID().Hex())
+	repo.StarsCount = 1
+	ctx := newHTTPContext(map[string]string{"user": "user"}, "")
+	ctx.query = map[string]string{"limit": "10", "cursor": "abc"}
+
+	serviceMock.On("ListStarred", mock.Anything, "user", &model.SubscriptionQuery{Limit: 10, Cursor: "abc"}).
+		Return(&model.RepoPage{Repos: []*model.PrivateRepoModel{repo}, NextCursor: "next"}, nil)
+
+	handler.NewSubscriptionHandler(serviceMock).ListStarred(ctx)
+
+	assert.Equal(t, http.StatusOK, ctx.StatusCode)
+	response := ctx.Response.(*public_repo.RepoListModel)
+	assert.Equal(t, []interface{}{repo.ToPublicRepoModel()}, response.Repos)
+	assert.Equal(t, int64(1), response.Repos[0].(*public_repo.PublicRepoModel).StarsCount)
+	assert.Equal(t, "next", response.NextCursor)
+
+	serviceMock.AssertExpectations(t)
+}
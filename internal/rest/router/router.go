@@ -8,5 +8,6 @@ type Router interface {
 	GET(path string, handler HandlerFunc)
 	POST(path string, handler HandlerFunc)
 	PUT(path string, handler HandlerFunc)
+	PATCH(path string, handler HandlerFunc)
 	DELETE(path string, handler HandlerFunc)
 }
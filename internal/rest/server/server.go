@@ -1,25 +1,61 @@
 package server
 
-import "github.com/gofiber/fiber/v2"
+import (
+	"context"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/validation"
+	"github.com/gofiber/fiber/v2"
+)
 
 type HTTPContext interface {
+	Context() context.Context
 	GetParam(key string) string
+	GetQuery(key string) string
+	GetHeader(key string) string
+	SetHeader(key string, value string)
 	BindJSON(obj interface{}) error
 	JSON(code int, obj interface{})
+	Status(code int)
 }
 
 type FiberContextAdapter struct {
 	Ctx *fiber.Ctx
 }
 
+func (f *FiberContextAdapter) Context() context.Context {
+	return f.Ctx.UserContext()
+}
+
 func (f *FiberContextAdapter) GetParam(key string) string {
 	return f.Ctx.Params(key)
 }
 
+func (f *FiberContextAdapter) GetQuery(key string) string {
+	return f.Ctx.Query(key)
+}
+
+func (f *FiberContextAdapter) GetHeader(key string) string {
+	return f.Ctx.Get(key)
+}
+
+func (f *FiberContextAdapter) SetHeader(key string, value string) {
+	f.Ctx.Set(key, value)
+}
+
+// BindJSON parses the body into obj and enforces its binding tags, a failed
+// check returns a repoerr validation error
 func (f *FiberContextAdapter) BindJSON(obj interface{}) error {
-	return f.Ctx.BodyParser(obj)
+	if err := f.Ctx.BodyParser(obj); err != nil {
+		return err
+	}
+
+	return validation.Struct(obj)
 }
 
 func (f *FiberContextAdapter) JSON(code int, obj interface{}) {
 	f.Ctx.Status(code).JSON(obj)
 }
+
+func (f *FiberContextAdapter) Status(code int) {
+	f.Ctx.Status(code)
+}
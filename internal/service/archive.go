@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/authz"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+)
+
+// archivedActions may still be performed on an archived repo: unarchiving, deleting
+// and reading. Anything else, including actions added later, is refused with a
+// repoerr ReadOnly error until it is unarchived.
+var archivedActions = map[authz.Action]bool{
+	authz.ActionArchive:           true,
+	authz.ActionDelete:            true,
+	authz.ActionListCollaborators: true,
+}
+
+// Archive makes a repo read-only, every change is audited
+func (s *RepoServiceImpl) Archive(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	return s.setArchived(ctx, id, true)
+}
+
+// Unarchive makes an archived repo writable again
+func (s *RepoServiceImpl) Unarchive(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	return s.setArchived(ctx, id, false)
+}
+
+func (s *RepoServiceImpl) setArchived(ctx context.Context, id string, archived bool) (*model.PrivateRepoModel, error) {
+	repo, err := s.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	caller, err := s.authorize(ctx, authz.ActionArchive, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	if repo.Archived() == archived {
+		return repo, nil
+	}
+
+	updated, err := s.Repository.PatchOne(ctx, id, &model.RepoPatch{
+		Archived:  &archived,
+		Version:   repo.Version,
+		UpdatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	action := model.AuditRepoUnarchived
+	if archived {
+		action = model.AuditRepoArchived
+	}
+
+	err = s.Audit.Record(ctx, &model.AuditEntry{
+		RepoID:    repo.ID,
+		ActorID:   caller.ID,
+		Action:    action,
+		CreatedAt: updated.UpdatedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("record archive change: %w", err)
+	}
+
+	return updated, nil
+}
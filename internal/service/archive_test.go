@@ -0,0 +1,113 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchive_ReadOnly(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+
+	archived, err := repoService.Archive(as("owner"), repo.ID.Hex())
+
+	require.NoError(t, err)
+	assert.True(t, archived.Archived())
+	assert.True(t, archived.ToPublicRepoModel().Archived)
+
+	description := "patched"
+	_, err = repoService.Patch(as("owner"), repo.ID.Hex(), &model.RepoPatch{Description: &description})
+	assert.ErrorIs(t, err, repoerr.ErrReadOnly)
+	archived.Description = description
+	_, err = repoService.Update(as("owner"), archived)
+	assert.ErrorIs(t, err, repoerr.ErrReadOnly)
+	_, err = repoService.Rename(as("owner"), repo.ID.Hex(), "platform", false)
+	assert.ErrorIs(t, err, repoerr.ErrReadOnly)
+
+	_, err = repoService.SetVisibility(as("owner"), repo.ID.Hex(), model.VisibilityPrivate)
+	assert.ErrorIs(t, err, repoerr.ErrReadOnly)
+	_, err = repoService.AddCollaborator(as("owner"), repo.ID.Hex(), "friend", model.CollaboratorRead)
+	assert.ErrorIs(t, err, repoerr.ErrReadOnly)
+	_, err = repoService.Transfer(as("owner"), repo.ID.Hex(), &model.RepoTransfer{NewOwner: "heir"})
+	assert.ErrorIs(t, err, repoerr.ErrReadOnly)
+
+	// Reading still works
+	_, err = repoService.ListCollaborators(as("owner"), repo.ID.Hex())
+	assert.Nil(t, err)
+
+	unarchived, err := repoService.Unarchive(as("owner"), repo.ID.Hex())
+	require.NoError(t, err)
+	assert.False(t, unarchived.Archived())
+
+	patched, err := repoService.Patch(as("owner"), repo.ID.Hex(), &model.RepoPatch{Description: &description})
+	require.NoError(t, err)
+	assert.Equal(t, description, patched.Description)
+
+	entries, _ := repoService.Audit.ListByRepo(context.TODO(), repo.ID.Hex())
+	actions := []string{}
+	for _, entry := range entries {
+		actions = append(actions, entry.Action)
+	}
+	assert.Contains(t, actions, model.AuditRepoArchived)
+	assert.Contains(t, actions, model.AuditRepoUnarchived)
+}
+
+func TestArchive_Error_NotAllowed(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	_, err = repoService.AddCollaborator(as("owner"), repo.ID.Hex(), "maintainer", model.CollaboratorMaintain)
+	require.NoError(t, err)
+
+	_, err = repoService.Archive(as("maintainer"), repo.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+
+	_, err = repoService.Archive(as("owner"), repo.ID.Hex())
+	require.NoError(t, err)
+
+	// Refusals by the policy come before the read-only check
+	description := "patched"
+	_, err = repoService.Patch(as("someone"), repo.ID.Hex(), &model.RepoPatch{Description: &description})
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+}
+
+func TestArchive_Idempotent(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+
+	first, err := repoService.Archive(as("owner"), repo.ID.Hex())
+	require.NoError(t, err)
+	second, err := repoService.Archive(as("owner"), repo.ID.Hex())
+	require.NoError(t, err)
+
+	assert.Equal(t, first.Version, second.Version)
+	entries, _ := repoService.Audit.ListByRepo(context.TODO(), repo.ID.Hex())
+	assert.Len(t, entries, 1)
+}
+
+func TestList_Archived(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	active, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "active"})
+	require.NoError(t, err)
+	archived, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "archived"})
+	require.NoError(t, err)
+	_, err = repoService.Archive(as("owner"), archived.ID.Hex())
+	require.NoError(t, err)
+
+	exclude := false
+	page, err := repoService.List(as("owner"), &model.RepoListQuery{Archived: &exclude})
+
+	require.NoError(t, err)
+	require.Len(t, page.Repos, 1)
+	assert.Equal(t, active.ID, page.Repos[0].ID)
+}
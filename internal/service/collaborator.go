@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/authz"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+)
+
+// CollaboratorService manages who besides the owner has a role on a repo
+type CollaboratorService interface {
+	ListCollaborators(ctx context.Context, repoID string) ([]*model.Collaborator, error)
+	AddCollaborator(ctx context.Context, repoID string, userID string, role string) (*model.Collaborator, error)
+	SetCollaboratorRole(ctx context.Context, repoID string, userID string, role string) (*model.Collaborator, error)
+	RemoveCollaborator(ctx context.Context, repoID string, userID string) error
+}
+
+func (s *RepoServiceImpl) ListCollaborators(ctx context.Context, repoID string) ([]*model.Collaborator, error) {
+	repo, err := s.FindById(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorize(ctx, authz.ActionListCollaborators, repo); err != nil {
+		return nil, err
+	}
+
+	return s.Collaborators.ListByRepo(ctx, repo.ID)
+}
+
+// AddCollaborator grants a user a role on a repo, the owner cannot be added
+func (s *RepoServiceImpl) AddCollaborator(ctx context.Context, repoID string, userID string, role string) (*model.Collaborator, error) {
+	if err := validateCollaborator(userID, role); err != nil {
+		return nil, err
+	}
+
+	repo, err := s.FindById(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+	caller, err := s.authorize(ctx, authz.ActionManageCollaborators, repo)
+	if err != nil {
+		return nil, err
+	}
+	if userID == repo.OwnerID {
+		return nil, repoerr.Validation(repoerr.Violation{Field: "userId", Message: "is the owner of the repo"})
+	}
+
+	now := time.Now()
+	added, err := s.Collaborators.Add(ctx, &model.Collaborator{
+		RepoID:    repo.ID,
+		UserID:    userID,
+		Role:      role,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.recordCollaboratorChange(ctx, repo, caller.ID, model.AuditCollaboratorAdded, map[string]string{"user": userID, "role": role})
+	if err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+func (s *RepoServiceImpl) SetCollaboratorRole(ctx context.Context, repoID string, userID string, role string) (*model.Collaborator, error) {
+	if err := validateCollaborator(userID, role); err != nil {
+		return nil, err
+	}
+
+	repo, err := s.FindById(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+	caller, err := s.authorize(ctx, authz.ActionManageCollaborators, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.Collaborators.Find(ctx, repo.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Role == role {
+		return current, nil
+	}
+
+	updated, err := s.Collaborators.UpdateRole(ctx, repo.ID, userID, role, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.recordCollaboratorChange(ctx, repo, caller.ID, model.AuditCollaboratorUpdated, map[string]string{"user": userID, "from": current.Role, "to": role})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// RemoveCollaborator revokes a user's role, collaborators may always remove themselves
+func (s *RepoServiceImpl) RemoveCollaborator(ctx context.Context, repoID string, userID string) error {
+	repo, err := s.FindById(ctx, repoID)
+	if err != nil {
+		return err
+	}
+
+	caller, err := s.authorize(ctx, authz.ActionManageCollaborators, repo)
+	leaving := caller.ID != "" && caller.ID == userID
+	if err != nil && !leaving {
+		return err
+	}
+
+	if err := s.Collaborators.Remove(ctx, repo.ID, userID); err != nil {
+		return err
+	}
+
+	return s.recordCollaboratorChange(ctx, repo, caller.ID, model.AuditCollaboratorRemoved, map[string]string{"user": userID})
+}
+
+func (s *RepoServiceImpl) recordCollaboratorChange(ctx context.Context, repo *model.PrivateRepoModel, actorID string, action string, details map[string]string) error {
+	err := s.Audit.Record(ctx, &model.AuditEntry{
+		RepoID:    repo.ID,
+		ActorID:   actorID,
+		Action:    action,
+		Details:   details,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("record collaborator change: %w", err)
+	}
+
+	return nil
+}
+
+func validateCollaborator(userID string, role string) error {
+	violations := []repoerr.Violation{}
+	if strings.TrimSpace(userID) == "" {
+		violations = append(violations, repoerr.Violation{Field: "userId", Message: "is required"})
+	}
+
+	if !validCollaboratorRole(role) {
+		violations = append(violations, roleViolation())
+	}
+
+	if len(violations) > 0 {
+		return repoerr.Validation(violations...)
+	}
+
+	return nil
+}
+
+func validCollaboratorRole(role string) bool {
+	for _, known := range model.CollaboratorRoles {
+		if role == known {
+			return true
+		}
+	}
+
+	return false
+}
+
+func roleViolation() repoerr.Violation {
+	return repoerr.Violation{Field: "role", Message: "must be one of " + strings.Join(model.CollaboratorRoles, ", ")}
+}
@@ -0,0 +1,171 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func as(userID string) context.Context {
+	return identity.NewContext(context.TODO(), identity.Caller{ID: userID})
+}
+
+func asAdmin(userID string) context.Context {
+	return identity.NewContext(context.TODO(), identity.Caller{ID: userID, Roles: []string{"admin"}})
+}
+
+func TestAddCollaborator_Success(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner"}
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	service := service.NewRepoService(repositoryMock)
+
+	added, err := service.AddCollaborator(as("owner"), repo.ID.Hex(), "user", model.CollaboratorWrite)
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.CollaboratorWrite, added.Role)
+
+	listed, err := service.ListCollaborators(as("user"), repo.ID.Hex())
+	assert.Nil(t, err)
+	assert.Len(t, listed, 1)
+
+	entries, _ := service.Audit.ListByRepo(context.TODO(), repo.ID.Hex())
+	assert.Len(t, entries, 1)
+	assert.Equal(t, model.AuditCollaboratorAdded, entries[0].Action)
+	assert.Equal(t, map[string]string{"user": "user", "role": "write"}, entries[0].Details)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestAddCollaborator_Error(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner"}
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	service := service.NewRepoService(repositoryMock)
+	_, err := service.AddCollaborator(as("owner"), repo.ID.Hex(), "maintainer", model.CollaboratorMaintain)
+	assert.Nil(t, err)
+
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		userID   string
+		role     string
+		expected error
+	}{
+		{"unknown role", as("owner"), "user", "superuser", repoerr.ErrValidationFailed},
+		{"owner", as("owner"), "owner", model.CollaboratorRead, repoerr.ErrValidationFailed},
+		{"already added", as("owner"), "maintainer", model.CollaboratorRead, repoerr.ErrConflict},
+		{"maintainer adds", as("maintainer"), "user", model.CollaboratorRead, repoerr.ErrPermissionDenied},
+		{"anonymous", context.TODO(), "user", model.CollaboratorRead, repoerr.ErrUnauthenticated},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := service.AddCollaborator(tc.ctx, repo.ID.Hex(), tc.userID, tc.role)
+
+			assert.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
+
+func TestSetCollaboratorRole_Success(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner"}
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	service := service.NewRepoService(repositoryMock)
+	_, err := service.AddCollaborator(as("owner"), repo.ID.Hex(), "user", model.CollaboratorRead)
+	assert.Nil(t, err)
+
+	updated, err := service.SetCollaboratorRole(as("owner"), repo.ID.Hex(), "user", model.CollaboratorAdmin)
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.CollaboratorAdmin, updated.Role)
+
+	// As a repo admin the collaborator may now manage others
+	_, err = service.AddCollaborator(as("user"), repo.ID.Hex(), "other", model.CollaboratorRead)
+	assert.Nil(t, err)
+
+	entries, _ := service.Audit.ListByRepo(context.TODO(), repo.ID.Hex())
+	assert.Len(t, entries, 3)
+	assert.Equal(t, map[string]string{"user": "user", "from": "read", "to": "admin"}, entries[1].Details)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestRemoveCollaborator(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner"}
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	service := service.NewRepoService(repositoryMock)
+	_, err := service.AddCollaborator(as("owner"), repo.ID.Hex(), "first", model.CollaboratorRead)
+	assert.Nil(t, err)
+	_, err = service.AddCollaborator(as("owner"), repo.ID.Hex(), "second", model.CollaboratorRead)
+	assert.Nil(t, err)
+
+	err = service.RemoveCollaborator(as("first"), repo.ID.Hex(), "second")
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+
+	// Leaving needs no permission
+	assert.Nil(t, service.RemoveCollaborator(as("first"), repo.ID.Hex(), "first"))
+	assert.Nil(t, service.RemoveCollaborator(as("owner"), repo.ID.Hex(), "second"))
+
+	err = service.RemoveCollaborator(as("owner"), repo.ID.Hex(), "second")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestFindById_Private_VisibleToCollaborators(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner", Visibility: model.VisibilityPrivate}
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	service := service.NewRepoService(repositoryMock)
+
+	_, err := service.FindById(as("user"), repo.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = service.AddCollaborator(as("owner"), repo.ID.Hex(), "user", model.CollaboratorRead)
+	assert.Nil(t, err)
+
+	found, err := service.FindById(as("user"), repo.ID.Hex())
+	assert.Nil(t, err)
+	assert.Equal(t, repo, found)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestPurgeTrash_RemovesCollaborators(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner"}
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+	repositoryMock.On("ListTrashed", mock.Anything, mock.Anything).Return([]*model.PrivateRepoModel{repo}, nil)
+	repositoryMock.On("DeleteOne", mock.Anything, repo).Return(nil)
+	repositoryMock.On("List", mock.Anything, &model.RepoListQuery{ParentID: repo.ID}).Return(&model.RepoPage{}, nil)
+
+	service := service.NewRepoService(repositoryMock)
+	_, err := service.AddCollaborator(as("owner"), repo.ID.Hex(), "user", model.CollaboratorRead)
+	assert.Nil(t, err)
+
+	purged, err := service.PurgeTrash(context.TODO(), time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, purged)
+
+	ids, err := service.Collaborators.ListRepoIDsByUser(context.TODO(), "user")
+	assert.Nil(t, err)
+	assert.Empty(t, ids)
+
+	repositoryMock.AssertExpectations(t)
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+)
+
+// CountService brings the counts kept on a repo back in line with what they count.
+// Counts are changed next to the change they follow, so one can drift when the
+// service stops between the two.
+type CountService interface {
+	ReconcileCounts(ctx context.Context, id string) (*model.PrivateRepoModel, error)
+}
+
+// ReconcileCounts recounts the direct forks of a repo outside the trash, its stars and
+// its watchers, and stores the results. Only platform admins may, the repo need not
+// be visible otherwise.
+func (s *RepoServiceImpl) ReconcileCounts(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	caller, _ := identity.FromContext(ctx)
+	if caller.ID == "" {
+		return nil, repoerr.Unauthenticated("sign in to reconcile counts")
+	}
+	if !isAdmin(caller) {
+		return nil, repoerr.PermissionDenied("only admins reconcile counts")
+	}
+
+	repo, err := s.Repository.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	forks, err := s.Repository.Count(ctx, &model.RepoListQuery{ParentID: repo.ID})
+	if err != nil {
+		return nil, fmt.Errorf("count forks: %w", err)
+	}
+	if err := s.Repository.SetForks(ctx, id, forks); err != nil {
+		return nil, err
+	}
+
+	stars, err := s.Subscriptions.Count(ctx, repo.ID, model.SubscriptionStar)
+	if err != nil {
+		return nil, fmt.Errorf("count stars: %w", err)
+	}
+	if err := s.Repository.SetStars(ctx, id, stars); err != nil {
+		return nil, err
+	}
+
+	watchers, err := s.Subscriptions.Count(ctx, repo.ID, model.SubscriptionWatch)
+	if err != nil {
+		return nil, fmt.Errorf("count watchers: %w", err)
+	}
+	if err := s.Repository.SetWatchers(ctx, id, watchers); err != nil {
+		return nil, err
+	}
+
+	return s.Repository.FindById(ctx, id)
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ForkService forks repos and walks fork networks
+type ForkService interface {
+	Fork(ctx context.Context, id string, fork *model.RepoFork) (*model.PrivateRepoModel, error)
+	ListForks(ctx context.Context, id string, query *model.RepoListQuery) (*model.RepoPage, error)
+	ListNetwork(ctx context.Context, id string, query *model.RepoListQuery) (*model.RepoPage, error)
+}
+
+// Fork copies a repo the caller can see into a new repo of the caller, or of one of
+// their organizations. The fork keeps the description and visibility of its parent
+// and joins the parent's fork network. A fork that cannot be counted on its parent is
+// removed again.
+func (s *RepoServiceImpl) Fork(ctx context.Context, id string, fork *model.RepoFork) (*model.PrivateRepoModel, error) {
+	caller, _ := identity.FromContext(ctx)
+	if caller.ID == "" {
+		return nil, repoerr.Unauthenticated("sign in to fork a repo")
+	}
+
+	parent, err := s.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	name := fork.Name
+	if name == "" {
+		name = parent.Name
+	}
+
+	repo, err := s.newRepo(ctx, &public_repo.CreateRepoModel{
+		Name:         name,
+		Description:  parent.Description,
+		Visibility:   parent.EffectiveVisibility(),
+		Organization: fork.Organization,
+	})
+	if err != nil {
+		return nil, err
+	}
+	rootID := parent.NetworkID()
+	repo.ParentID = &parent.ID
+	repo.RootID = &rootID
+
+	created, err := s.insert(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.countFork(ctx, created, 1); err != nil {
+		if deleteErr := s.Repository.DeleteOne(ctx, created); deleteErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("remove uncounted fork: %w", deleteErr))
+		}
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// ListForks pages through the direct forks of a repo that the caller can see
+func (s *RepoServiceImpl) ListForks(ctx context.Context, id string, query *model.RepoListQuery) (*model.RepoPage, error) {
+	repo, err := s.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	query.ParentID = repo.ID
+	return s.List(ctx, query)
+}
+
+// ListNetwork pages through the repos of the fork network a repo belongs to, its
+// root included, that the caller can see
+func (s *RepoServiceImpl) ListNetwork(ctx context.Context, id string, query *model.RepoListQuery) (*model.RepoPage, error) {
+	repo, err := s.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	query.NetworkID = repo.NetworkID()
+	return s.List(ctx, query)
+}
+
+// countFork changes the fork count of the parent of repo by delta. A parent that was
+// purged meanwhile has nothing left to count.
+func (s *RepoServiceImpl) countFork(ctx context.Context, repo *model.PrivateRepoModel, delta int) error {
+	if !repo.IsFork() {
+		return nil
+	}
+
+	err := s.Repository.AddForks(ctx, repo.ParentID.Hex(), delta)
+	if err != nil && !errors.Is(err, repoerr.ErrNotFound) {
+		return fmt.Errorf("count fork: %w", err)
+	}
+
+	return nil
+}
+
+// reparentForks hands the forks of a purged repo down to its own parent. Forks of a
+// purged root have no parent left, the network keeps the root's ID though. Each fork
+// is counted on the new parent as it moves, so running it again only moves the rest.
+func (s *RepoServiceImpl) reparentForks(ctx context.Context, repo *model.PrivateRepoModel) error {
+	forks, err := s.Repository.List(ctx, &model.RepoListQuery{ParentID: repo.ID})
+	if err != nil {
+		return err
+	}
+
+	for _, fork := range forks.Repos {
+		if err := s.Repository.SetForkLinks(ctx, fork.ID.Hex(), repo.ParentID, fork.RootID); err != nil {
+			return err
+		}
+		if err := s.countFork(ctx, repo, 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// splitNetwork keeps forks from being more visible than their parent, so a fork never
+// points callers at a repo they may not see. After repo changed its visibility, it
+// leaves its network if it is now more visible than its parent, and forks of it that
+// are more visible than it leave in turn. The same holds for a repo coming back from
+// the trash. The repo is returned as stored afterwards.
+func (s *RepoServiceImpl) splitNetwork(ctx context.Context, repo *model.PrivateRepoModel) (*model.PrivateRepoModel, error) {
+	if repo.ForksCount > 0 {
+		forks, err := s.Repository.List(ctx, &model.RepoListQuery{ParentID: repo.ID})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, fork := range forks.Repos {
+			if moreVisible(fork, repo) {
+				if err := s.detach(ctx, fork); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if !repo.IsFork() {
+		return repo, nil
+	}
+
+	parent, err := s.Repository.FindById(ctx, repo.ParentID.Hex())
+	switch {
+	case errors.Is(err, repoerr.ErrNotFound):
+		return repo, nil
+	case err != nil:
+		return nil, err
+	case !moreVisible(repo, parent):
+		return repo, nil
+	}
+
+	if err := s.detach(ctx, repo); err != nil {
+		return nil, err
+	}
+
+	return s.Repository.FindById(ctx, repo.ID.Hex())
+}
+
+// detach makes a fork the root of a fork network of its own, taking its forks along
+func (s *RepoServiceImpl) detach(ctx context.Context, fork *model.PrivateRepoModel) error {
+	if err := s.Repository.SetForkLinks(ctx, fork.ID.Hex(), nil, nil); err != nil {
+		return err
+	}
+	if err := s.countFork(ctx, fork, -1); err != nil {
+		return err
+	}
+
+	parents := []primitive.ObjectID{fork.ID}
+	for len(parents) > 0 {
+		descendants, err := s.Repository.List(ctx, &model.RepoListQuery{ParentID: parents[0]})
+		if err != nil {
+			return err
+		}
+		parents = parents[1:]
+
+		for _, descendant := range descendants.Repos {
+			if err := s.Repository.SetForkLinks(ctx, descendant.ID.Hex(), descendant.ParentID, &fork.ID); err != nil {
+				return err
+			}
+			parents = append(parents, descendant.ID)
+		}
+	}
+
+	return nil
+}
+
+// moreVisible reports whether repo can be seen by callers who cannot see other
+func moreVisible(repo *model.PrivateRepoModel, other *model.PrivateRepoModel) bool {
+	return visibilityRank(repo.EffectiveVisibility()) > visibilityRank(other.EffectiveVisibility())
+}
+
+func visibilityRank(visibility string) int {
+	switch visibility {
+	case model.VisibilityPublic:
+		return 2
+	case model.VisibilityInternal:
+		return 1
+	}
+
+	return 0
+}
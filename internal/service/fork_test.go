@@ -0,0 +1,216 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFork(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	parent, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools", Description: "Shared tools", Visibility: model.VisibilityInternal})
+	require.NoError(t, err)
+
+	fork, err := repoService.Fork(as("someone"), parent.ID.Hex(), &model.RepoFork{})
+
+	require.NoError(t, err)
+	assert.Equal(t, "someone", fork.OwnerID)
+	assert.Equal(t, "tools", fork.Name)
+	assert.Equal(t, "Shared tools", fork.Description)
+	assert.Equal(t, model.VisibilityInternal, fork.EffectiveVisibility())
+	assert.Equal(t, parent.ID, *fork.ParentID)
+	assert.Equal(t, parent.ID, fork.NetworkID())
+
+	nested, err := repoService.Fork(as("other"), fork.ID.Hex(), &model.RepoFork{Name: "my-tools"})
+	require.NoError(t, err)
+	assert.Equal(t, "my-tools", nested.Name)
+	assert.Equal(t, fork.ID, *nested.ParentID)
+	assert.Equal(t, parent.ID, nested.NetworkID())
+
+	found, err := repoService.FindById(as("owner"), parent.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), found.ForksCount)
+	assert.Equal(t, int64(1), found.ToPublicRepoModel().ForksCount)
+
+	_, err = repoService.Fork(as("someone"), parent.ID.Hex(), &model.RepoFork{})
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+}
+
+func TestFork_Error(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	parent, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools", Visibility: model.VisibilityPrivate})
+	require.NoError(t, err)
+
+	_, err = repoService.Fork(context.TODO(), parent.ID.Hex(), &model.RepoFork{})
+	assert.ErrorIs(t, err, repoerr.ErrUnauthenticated)
+
+	_, err = repoService.Fork(as("someone"), parent.ID.Hex(), &model.RepoFork{})
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = repoService.Fork(as("owner"), parent.ID.Hex(), &model.RepoFork{Organization: "acme"})
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+}
+
+func TestListForksAndNetwork(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	parent, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	fork, err := repoService.Fork(as("someone"), parent.ID.Hex(), &model.RepoFork{})
+	require.NoError(t, err)
+	_, err = repoService.Fork(as("other"), fork.ID.Hex(), &model.RepoFork{Name: "nested"})
+	require.NoError(t, err)
+
+	forks, err := repoService.ListForks(as("owner"), parent.ID.Hex(), &model.RepoListQuery{})
+	require.NoError(t, err)
+	require.Len(t, forks.Repos, 1)
+	assert.Equal(t, fork.ID, forks.Repos[0].ID)
+
+	for _, id := range []string{parent.ID.Hex(), fork.ID.Hex()} {
+		network, err := repoService.ListNetwork(as("owner"), id, &model.RepoListQuery{SortBy: model.SortByName})
+		require.NoError(t, err)
+		assert.Len(t, network.Repos, 3)
+	}
+}
+
+func TestFork_ParentDeleted(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	root, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	fork, err := repoService.Fork(as("someone"), root.ID.Hex(), &model.RepoFork{})
+	require.NoError(t, err)
+	nested, err := repoService.Fork(as("other"), fork.ID.Hex(), &model.RepoFork{})
+	require.NoError(t, err)
+
+	require.NoError(t, repoService.Delete(as("someone"), fork))
+	found, err := repoService.FindById(as("owner"), root.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), found.ForksCount)
+
+	_, err = repoService.PurgeTrash(context.TODO(), time.Now().Add(repoService.TrashRetention+time.Minute))
+	require.NoError(t, err)
+
+	// The nested fork moves up to the root
+	found, err = repoService.FindById(as("other"), nested.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, root.ID, *found.ParentID)
+	assert.Equal(t, root.ID, found.NetworkID())
+	found, err = repoService.FindById(as("owner"), root.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), found.ForksCount)
+
+	// Forks of a purged root keep their network
+	require.NoError(t, repoService.Delete(as("owner"), found))
+	_, err = repoService.PurgeTrash(context.TODO(), time.Now().Add(repoService.TrashRetention+time.Minute))
+	require.NoError(t, err)
+
+	found, err = repoService.FindById(as("other"), nested.ID.Hex())
+	require.NoError(t, err)
+	assert.False(t, found.IsFork())
+	assert.Equal(t, root.ID, found.NetworkID())
+}
+
+func TestFork_RestoreCountsAgain(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	parent, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	fork, err := repoService.Fork(as("someone"), parent.ID.Hex(), &model.RepoFork{})
+	require.NoError(t, err)
+	require.NoError(t, repoService.Delete(as("someone"), fork))
+
+	_, err = repoService.Restore(as("someone"), fork.ID.Hex())
+
+	require.NoError(t, err)
+	found, err := repoService.FindById(as("owner"), parent.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), found.ForksCount)
+}
+
+func TestFork_ParentMadePrivate(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	parent, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	fork, err := repoService.Fork(as("someone"), parent.ID.Hex(), &model.RepoFork{})
+	require.NoError(t, err)
+	nested, err := repoService.Fork(as("other"), fork.ID.Hex(), &model.RepoFork{})
+	require.NoError(t, err)
+
+	_, err = repoService.SetVisibility(as("owner"), parent.ID.Hex(), model.VisibilityPrivate)
+	require.NoError(t, err)
+
+	// The fork no longer points at a repo its viewers cannot see
+	found, err := repoService.FindById(as("someone"), fork.ID.Hex())
+	require.NoError(t, err)
+	assert.False(t, found.IsFork())
+	assert.Equal(t, fork.ID, found.NetworkID())
+	found, err = repoService.FindById(as("other"), nested.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, fork.ID, *found.ParentID)
+	assert.Equal(t, fork.ID, found.NetworkID())
+	found, err = repoService.FindById(as("owner"), parent.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), found.ForksCount)
+}
+
+func TestFork_MadeMoreVisibleThanParent(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	parent, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools", Visibility: model.VisibilityInternal})
+	require.NoError(t, err)
+	fork, err := repoService.Fork(as("someone"), parent.ID.Hex(), &model.RepoFork{})
+	require.NoError(t, err)
+
+	updated, err := repoService.SetVisibility(as("someone"), fork.ID.Hex(), model.VisibilityPublic)
+
+	require.NoError(t, err)
+	assert.False(t, updated.IsFork())
+	found, err := repoService.FindById(as("owner"), parent.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), found.ForksCount)
+}
+
+// uncountedRepos loses every change to a fork count
+type uncountedRepos struct {
+	*repository.MemoryRepoRepository
+}
+
+func (uncountedRepos) AddForks(ctx context.Context, id string, delta int) error {
+	return assert.AnError
+}
+
+func TestFork_Error_CountFails(t *testing.T) {
+	repoService := service.NewRepoService(uncountedRepos{repository.NewMemoryRepoRepository()})
+	parent, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+
+	_, err = repoService.Fork(as("someone"), parent.ID.Hex(), &model.RepoFork{})
+	assert.ErrorIs(t, err, assert.AnError)
+
+	forks, err := repoService.ListForks(as("owner"), parent.ID.Hex(), &model.RepoListQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, forks.Repos)
+}
+
+func TestReconcileCounts_Forks(t *testing.T) {
+	repos := repository.NewMemoryRepoRepository()
+	repoService := service.NewRepoService(repos)
+	parent, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	_, err = repoService.Fork(as("someone"), parent.ID.Hex(), &model.RepoFork{})
+	require.NoError(t, err)
+	trashed, err := repoService.Fork(as("other"), parent.ID.Hex(), &model.RepoFork{})
+	require.NoError(t, err)
+	require.NoError(t, repoService.Delete(as("other"), trashed))
+	require.NoError(t, repos.AddForks(context.TODO(), parent.ID.Hex(), 3))
+
+	reconciled, err := repoService.ReconcileCounts(asAdmin("admin"), parent.ID.Hex())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), reconciled.ForksCount)
+}
@@ -0,0 +1,420 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/authz"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/naming"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+)
+
+// OrganizationService manages organizations, their members and teams. Organizations
+// are addressed by login and teams by slug within their organization.
+type OrganizationService interface {
+	CreateOrganization(ctx context.Context, login string, name string) (*model.Organization, error)
+	FindOrganization(ctx context.Context, login string) (*model.Organization, error)
+	SetOrgMember(ctx context.Context, login string, userID string, role string) (*model.Organization, error)
+	RemoveOrgMember(ctx context.Context, login string, userID string) error
+	ListTeams(ctx context.Context, login string) ([]*model.Team, error)
+	CreateTeam(ctx context.Context, login string, slug string, name string) (*model.Team, error)
+	AddTeamMember(ctx context.Context, login string, slug string, userID string) (*model.Team, error)
+	RemoveTeamMember(ctx context.Context, login string, slug string, userID string) (*model.Team, error)
+	SetTeamRepo(ctx context.Context, login string, slug string, repoID string, role string) (*model.Team, error)
+	RemoveTeamRepo(ctx context.Context, login string, slug string, repoID string) (*model.Team, error)
+}
+
+// CreateOrganization makes the caller the first owner of a new organization
+func (s *RepoServiceImpl) CreateOrganization(ctx context.Context, login string, name string) (*model.Organization, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok || caller.ID == "" {
+		return nil, repoerr.Unauthenticated("sign in to create an organization")
+	}
+
+	login, err := s.checkIdentifier("login", login)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkLoginFree(ctx, caller, login); err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = login
+	}
+
+	now := time.Now()
+	return s.Organizations.Create(ctx, &model.Organization{
+		Login:     login,
+		Name:      name,
+		Members:   []model.OrgMember{{UserID: caller.ID, Role: model.OrgRoleOwner}},
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+}
+
+func (s *RepoServiceImpl) FindOrganization(ctx context.Context, login string) (*model.Organization, error) {
+	return s.Organizations.FindByLogin(ctx, login)
+}
+
+// SetOrgMember adds a member or changes their role, the last owner cannot be demoted
+func (s *RepoServiceImpl) SetOrgMember(ctx context.Context, login string, userID string, role string) (*model.Organization, error) {
+	if err := validateOrgMember(userID, role); err != nil {
+		return nil, err
+	}
+
+	org, err := s.Organizations.FindByLogin(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizeOrg(ctx, org); err != nil {
+		return nil, err
+	}
+
+	if member, ok := org.Member(userID); ok && member.Role == model.OrgRoleOwner && role != model.OrgRoleOwner {
+		if err := checkOtherOwner(org, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.Organizations.SetMember(ctx, org.ID, model.OrgMember{UserID: userID, Role: role}, time.Now())
+}
+
+// RemoveOrgMember also takes the user out of the organization's teams. Members may
+// always leave, except for the last owner.
+func (s *RepoServiceImpl) RemoveOrgMember(ctx context.Context, login string, userID string) error {
+	org, err := s.Organizations.FindByLogin(ctx, login)
+	if err != nil {
+		return err
+	}
+
+	caller, err := s.authorizeOrg(ctx, org)
+	leaving := caller.ID != "" && caller.ID == userID
+	if err != nil && !leaving {
+		return err
+	}
+
+	if member, ok := org.Member(userID); ok && member.Role == model.OrgRoleOwner {
+		if err := checkOtherOwner(org, userID); err != nil {
+			return err
+		}
+	}
+
+	if _, err := s.Organizations.RemoveMember(ctx, org.ID, userID, time.Now()); err != nil {
+		return err
+	}
+
+	if err := s.Teams.RemoveOrgMember(ctx, org.ID, userID); err != nil {
+		return fmt.Errorf("remove from teams: %w", err)
+	}
+
+	return nil
+}
+
+// ListTeams is limited to members of the organization
+func (s *RepoServiceImpl) ListTeams(ctx context.Context, login string) ([]*model.Team, error) {
+	org, err := s.Organizations.FindByLogin(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+
+	caller, _ := identity.FromContext(ctx)
+	if caller.ID == "" {
+		return nil, repoerr.Unauthenticated("sign in to list the teams of an organization")
+	}
+	if _, member := org.Member(caller.ID); !member && !caller.HasRole(string(authz.RoleAdmin)) {
+		return nil, repoerr.PermissionDenied("not a member of organization %q", org.Login)
+	}
+
+	return s.Teams.ListByOrg(ctx, org.ID)
+}
+
+func (s *RepoServiceImpl) CreateTeam(ctx context.Context, login string, slug string, name string) (*model.Team, error) {
+	slug, err := s.checkIdentifier("slug", slug)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = slug
+	}
+
+	org, err := s.Organizations.FindByLogin(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizeOrg(ctx, org); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return s.Teams.Create(ctx, &model.Team{
+		OrgID:     org.ID,
+		Slug:      slug,
+		Name:      name,
+		Members:   []string{},
+		Repos:     []model.TeamRepo{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+}
+
+// AddTeamMember only accepts members of the team's organization
+func (s *RepoServiceImpl) AddTeamMember(ctx context.Context, login string, slug string, userID string) (*model.Team, error) {
+	org, team, err := s.manageTeam(ctx, login, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := org.Member(userID); !ok {
+		return nil, repoerr.Validation(repoerr.Violation{Field: "userId", Message: "is not a member of the organization"})
+	}
+
+	return s.Teams.AddMember(ctx, team.ID, userID, time.Now())
+}
+
+// RemoveTeamMember lets members leave a team themselves
+func (s *RepoServiceImpl) RemoveTeamMember(ctx context.Context, login string, slug string, userID string) (*model.Team, error) {
+	_, team, err := s.manageTeam(ctx, login, slug)
+	caller, _ := identity.FromContext(ctx)
+	leaving := team != nil && caller.ID != "" && caller.ID == userID
+	if err != nil && !leaving {
+		return nil, err
+	}
+
+	return s.Teams.RemoveMember(ctx, team.ID, userID, time.Now())
+}
+
+// SetTeamRepo grants a team one of the collaborator roles on a repo of its
+// organization, every change is audited on the repo
+func (s *RepoServiceImpl) SetTeamRepo(ctx context.Context, login string, slug string, repoID string, role string) (*model.Team, error) {
+	if !validCollaboratorRole(role) {
+		return nil, repoerr.Validation(roleViolation())
+	}
+
+	org, team, err := s.manageTeam(ctx, login, slug)
+	if err != nil {
+		return nil, err
+	}
+	caller, _ := identity.FromContext(ctx)
+
+	repo, err := s.orgRepo(ctx, org, repoID)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, granted := team.RepoRole(repo.ID)
+	if granted && previous == role {
+		return team, nil
+	}
+
+	updated, err := s.Teams.SetRepoRole(ctx, team.ID, repo.ID, role, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	details := map[string]string{"team": team.Slug, "role": role}
+	if granted {
+		details["from"] = previous
+	}
+	if err := s.recordTeamChange(ctx, repo, caller.ID, model.AuditTeamGranted, details); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *RepoServiceImpl) RemoveTeamRepo(ctx context.Context, login string, slug string, repoID string) (*model.Team, error) {
+	org, team, err := s.manageTeam(ctx, login, slug)
+	if err != nil {
+		return nil, err
+	}
+	caller, _ := identity.FromContext(ctx)
+
+	repo, err := s.orgRepo(ctx, org, repoID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.Teams.RemoveRepo(ctx, team.ID, repo.ID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.recordTeamChange(ctx, repo, caller.ID, model.AuditTeamRevoked, map[string]string{"team": team.Slug}); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// memberOrganization returns the organization named login if userID belongs to it
+func (s *RepoServiceImpl) memberOrganization(ctx context.Context, login string, userID string) (*model.Organization, error) {
+	org, err := s.Organizations.FindByLogin(ctx, login)
+	if errors.Is(err, repoerr.ErrNotFound) {
+		return nil, repoerr.Validation(repoerr.Violation{Field: "organization", Message: "does not exist"})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := org.Member(userID); !ok {
+		return nil, repoerr.PermissionDenied("not a member of organization %q", org.Login)
+	}
+
+	return org, nil
+}
+
+// authorizeOrg lets owners of the organization and platform admins manage it and
+// returns the caller
+func (s *RepoServiceImpl) authorizeOrg(ctx context.Context, org *model.Organization) (identity.Caller, error) {
+	caller, _ := identity.FromContext(ctx)
+	if caller.ID == "" {
+		return caller, repoerr.Unauthenticated("sign in to manage an organization")
+	}
+
+	if member, ok := org.Member(caller.ID); ok && member.Role == model.OrgRoleOwner {
+		return caller, nil
+	}
+	if caller.HasRole(string(authz.RoleAdmin)) {
+		return caller, nil
+	}
+
+	return caller, repoerr.PermissionDenied("not allowed to manage organization %q", org.Login)
+}
+
+// manageTeam looks up a team and authorizes the caller to manage its organization.
+// The team is returned along with a failed authorization, so members can leave it.
+func (s *RepoServiceImpl) manageTeam(ctx context.Context, login string, slug string) (*model.Organization, *model.Team, error) {
+	org, err := s.Organizations.FindByLogin(ctx, login)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	team, err := s.Teams.Find(ctx, org.ID, slug)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	_, err = s.authorizeOrg(ctx, org)
+	return org, team, err
+}
+
+// orgRepo finds a repo owned by org, the repos of others cannot be granted to its teams
+func (s *RepoServiceImpl) orgRepo(ctx context.Context, org *model.Organization, repoID string) (*model.PrivateRepoModel, error) {
+	repo, err := s.Repository.FindById(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+
+	if id, ok := repo.OrganizationID(); !ok || id != org.ID {
+		return nil, repoerr.Validation(repoerr.Violation{Field: "repoId", Message: "is not owned by the organization"})
+	}
+
+	return repo, nil
+}
+
+func (s *RepoServiceImpl) recordTeamChange(ctx context.Context, repo *model.PrivateRepoModel, actorID string, action string, details map[string]string) error {
+	err := s.Audit.Record(ctx, &model.AuditEntry{
+		RepoID:    repo.ID,
+		ActorID:   actorID,
+		Action:    action,
+		Details:   details,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("record team change: %w", err)
+	}
+
+	return nil
+}
+
+// checkIdentifier normalizes an organization login or team slug, which follow the
+// repo naming rules, and reports violations under field
+func (s *RepoServiceImpl) checkIdentifier(field string, identifier string) (string, error) {
+	identifier = naming.Normalize(identifier)
+	if err := s.Policy.Validate(identifier); err != nil {
+		violations := repoerr.ViolationsOf(err)
+		for i := range violations {
+			violations[i].Field = field
+		}
+		return identifier, repoerr.Validation(violations...)
+	}
+
+	return identifier, nil
+}
+
+// checkLoginFree refuses a login that is already a user ID. Organization logins take
+// precedence in full names, so the user's repos could no longer be addressed.
+func (s *RepoServiceImpl) checkLoginFree(ctx context.Context, caller identity.Caller, login string) error {
+	known, err := s.knownUser(ctx, caller, login)
+	if err != nil {
+		return err
+	}
+	if known {
+		return repoerr.Conflict("login %q is taken by a user", login)
+	}
+
+	return nil
+}
+
+// knownUser reports whether userID belongs to a user. Users are only known here as
+// the caller or by the repos they own, collaborate on or the organizations they
+// belong to.
+func (s *RepoServiceImpl) knownUser(ctx context.Context, caller identity.Caller, userID string) (bool, error) {
+	if userID == caller.ID {
+		return true, nil
+	}
+
+	page, err := s.Repository.List(ctx, &model.RepoListQuery{OwnerID: userID, Limit: 1})
+	if err != nil {
+		return false, err
+	}
+	if len(page.Repos) > 0 {
+		return true, nil
+	}
+
+	repoIDs, err := s.Collaborators.ListRepoIDsByUser(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if len(repoIDs) > 0 {
+		return true, nil
+	}
+
+	orgIDs, err := s.Organizations.ListIDsByMember(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	return len(orgIDs) > 0, nil
+}
+
+// checkOtherOwner makes sure an organization keeps an owner besides userID
+func checkOtherOwner(org *model.Organization, userID string) error {
+	for _, member := range org.Members {
+		if member.UserID != userID && member.Role == model.OrgRoleOwner {
+			return nil
+		}
+	}
+
+	return repoerr.Conflict("organization %q needs another owner first", org.Login)
+}
+
+func validateOrgMember(userID string, role string) error {
+	violations := []repoerr.Violation{}
+	if userID == "" {
+		violations = append(violations, repoerr.Violation{Field: "userId", Message: "is required"})
+	}
+	if role != model.OrgRoleOwner && role != model.OrgRoleMember {
+		violations = append(violations, repoerr.Violation{Field: "role", Message: "must be one of owner, member"})
+	}
+
+	if len(violations) > 0 {
+		return repoerr.Validation(violations...)
+	}
+
+	return nil
+}
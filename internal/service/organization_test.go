@@ -0,0 +1,180 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newOrgService runs against the memory store, with an organization "acme" owned by
+// "owner" that has "member" as a plain member
+func newOrgService(t *testing.T) *service.RepoServiceImpl {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+
+	_, err := repoService.CreateOrganization(as("owner"), "Acme", "Acme Corp")
+	require.NoError(t, err)
+	_, err = repoService.SetOrgMember(as("owner"), "acme", "member", model.OrgRoleMember)
+	require.NoError(t, err)
+
+	return repoService
+}
+
+func TestCreateOrganization(t *testing.T) {
+	repoService := newOrgService(t)
+
+	org, err := repoService.FindOrganization(context.TODO(), "acme")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "Acme Corp", org.Name)
+	assert.Equal(t, []model.OrgMember{{UserID: "owner", Role: model.OrgRoleOwner}, {UserID: "member", Role: model.OrgRoleMember}}, org.Members)
+
+	_, err = repoService.CreateOrganization(as("someone"), "acme", "")
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+
+	_, err = repoService.CreateOrganization(as("someone"), "a/b", "")
+	assert.Equal(t, "login", repoerr.ViolationsOf(err)[0].Field)
+
+	_, err = repoService.CreateOrganization(context.TODO(), "other", "")
+	assert.ErrorIs(t, err, repoerr.ErrUnauthenticated)
+}
+
+// An organization named after a user would hide the user's repos
+func TestCreateOrganization_Error_UserLogin(t *testing.T) {
+	repoService := newOrgService(t)
+	_, err := repoService.Create(as("alice"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		caller string
+		login  string
+	}{
+		{"someone", "alice"},  // Owns a repo
+		{"someone", "member"}, // Belongs to an organization
+		{"bob", "bob"},        // The caller
+	} {
+		_, err := repoService.CreateOrganization(as(tc.caller), tc.login, "")
+		assert.ErrorIs(t, err, repoerr.ErrConflict, tc.login)
+	}
+
+	found, err := repoService.FindByFullName(as("someone"), "alice/tools")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", found.OwnerID)
+}
+
+func TestCreate_Organization(t *testing.T) {
+	repoService := newOrgService(t)
+
+	created, err := repoService.Create(as("member"), &public_repo.CreateRepoModel{Name: "tools", Organization: "acme", Visibility: model.VisibilityPrivate})
+	require.NoError(t, err)
+	assert.Equal(t, model.OwnerOrganization, created.OwnerType)
+
+	found, err := repoService.FindByFullName(as("owner"), "acme/tools")
+	assert.Nil(t, err)
+	assert.Equal(t, created.ID, found.ID)
+
+	// Private organization repos are hidden from non members
+	_, err = repoService.FindByFullName(as("someone"), "acme/tools")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	page, err := repoService.List(as("member"), &model.RepoListQuery{OwnerID: "acme"})
+	assert.Nil(t, err)
+	assert.Len(t, page.Repos, 1)
+
+	_, err = repoService.Create(as("someone"), &public_repo.CreateRepoModel{Name: "tools", Organization: "acme"})
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+
+	_, err = repoService.Create(as("member"), &public_repo.CreateRepoModel{Name: "tools", Organization: "missing"})
+	assert.Equal(t, "organization", repoerr.ViolationsOf(err)[0].Field)
+}
+
+func TestOrganization_RepoPermissions(t *testing.T) {
+	repoService := newOrgService(t)
+	repo, err := repoService.Create(as("member"), &public_repo.CreateRepoModel{Name: "tools", Organization: "acme"})
+	require.NoError(t, err)
+
+	// Creating a repo in the organization does not make the member its owner
+	description := "updated"
+	_, err = repoService.Patch(as("member"), repo.ID.Hex(), &model.RepoPatch{Description: &description})
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+
+	_, err = repoService.CreateTeam(as("owner"), "acme", "core", "")
+	require.NoError(t, err)
+	_, err = repoService.AddTeamMember(as("owner"), "acme", "core", "member")
+	require.NoError(t, err)
+	team, err := repoService.SetTeamRepo(as("owner"), "acme", "core", repo.ID.Hex(), model.CollaboratorMaintain)
+	require.NoError(t, err)
+	assert.Equal(t, []model.TeamRepo{{RepoID: repo.ID, Role: model.CollaboratorMaintain}}, team.Repos)
+
+	_, err = repoService.Patch(as("member"), repo.ID.Hex(), &model.RepoPatch{Description: &description})
+	assert.Nil(t, err)
+
+	entries, _ := repoService.Audit.ListByRepo(context.TODO(), repo.ID.Hex())
+	assert.Len(t, entries, 1)
+	assert.Equal(t, model.AuditTeamGranted, entries[0].Action)
+
+	// Organization owners manage every repo of the organization
+	assert.Nil(t, repoService.Delete(as("owner"), repo))
+	_, err = repoService.PurgeTrash(context.TODO(), time.Now().Add(repoService.TrashRetention+time.Minute))
+	require.NoError(t, err)
+
+	team, err = repoService.AddTeamMember(as("owner"), "acme", "core", "owner")
+	require.NoError(t, err)
+	assert.Empty(t, team.Repos)
+}
+
+func TestTeams_Error(t *testing.T) {
+	repoService := newOrgService(t)
+	personal, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "personal"})
+	require.NoError(t, err)
+	_, err = repoService.CreateTeam(as("owner"), "acme", "core", "")
+	require.NoError(t, err)
+
+	_, err = repoService.CreateTeam(as("member"), "acme", "other", "")
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+
+	_, err = repoService.CreateTeam(as("owner"), "acme", "core", "")
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+
+	_, err = repoService.AddTeamMember(as("owner"), "acme", "core", "someone")
+	assert.Equal(t, "userId", repoerr.ViolationsOf(err)[0].Field)
+
+	_, err = repoService.SetTeamRepo(as("owner"), "acme", "core", personal.ID.Hex(), model.CollaboratorRead)
+	assert.Equal(t, "repoId", repoerr.ViolationsOf(err)[0].Field)
+
+	_, err = repoService.ListTeams(as("someone"), "acme")
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+
+	teams, err := repoService.ListTeams(as("member"), "acme")
+	assert.Nil(t, err)
+	assert.Len(t, teams, 1)
+}
+
+func TestRemoveOrgMember(t *testing.T) {
+	repoService := newOrgService(t)
+	_, err := repoService.CreateTeam(as("owner"), "acme", "core", "")
+	require.NoError(t, err)
+	_, err = repoService.AddTeamMember(as("owner"), "acme", "core", "member")
+	require.NoError(t, err)
+
+	// The last owner can neither leave nor be demoted
+	assert.ErrorIs(t, repoService.RemoveOrgMember(as("owner"), "acme", "owner"), repoerr.ErrConflict)
+	_, err = repoService.SetOrgMember(as("owner"), "acme", "owner", model.OrgRoleMember)
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+
+	assert.ErrorIs(t, repoService.RemoveOrgMember(as("someone"), "acme", "member"), repoerr.ErrPermissionDenied)
+
+	// Members may leave, which takes them out of the teams too
+	assert.Nil(t, repoService.RemoveOrgMember(as("member"), "acme", "member"))
+
+	teams, err := repoService.ListTeams(as("owner"), "acme")
+	assert.Nil(t, err)
+	assert.Empty(t, teams[0].Members)
+}
@@ -0,0 +1,127 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRename_Redirects(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+
+	renamed, err := repoService.Rename(as("owner"), repo.ID.Hex(), "Platform Tools", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, "platform-tools", renamed.Name)
+	assert.Equal(t, []string{"tools"}, renamed.PreviousNames)
+
+	found, err := repoService.FindByFindByIdentifier(as("someone"), "owner/tools")
+	require.NoError(t, err)
+	assert.Equal(t, repo.ID, found.ID)
+	assert.Equal(t, "owner/tools", found.RedirectedFrom)
+
+	// Former names need the owner to resolve
+	_, err = repoService.FindByFindByIdentifier(as("someone"), "tools")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	found, err = repoService.FindByName(as("someone"), "platform-tools")
+	require.NoError(t, err)
+	assert.Empty(t, found.RedirectedFrom)
+
+	entries, _ := repoService.Audit.ListByRepo(context.TODO(), repo.ID.Hex())
+	require.Len(t, entries, 1)
+	assert.Equal(t, model.AuditRepoRenamed, entries[0].Action)
+	assert.Equal(t, map[string]string{"from": "tools", "to": "platform-tools"}, entries[0].Details)
+}
+
+// Renames through Update and Patch are audited like Rename
+func TestRename_ThroughUpdateAndPatch(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+
+	repo.Name = "kit"
+	_, err = repoService.Update(as("owner"), repo)
+	require.NoError(t, err)
+
+	name := "platform"
+	_, err = repoService.Patch(as("owner"), repo.ID.Hex(), &model.RepoPatch{Name: &name})
+	require.NoError(t, err)
+
+	description := "unchanged name"
+	_, err = repoService.Patch(as("owner"), repo.ID.Hex(), &model.RepoPatch{Name: &name, Description: &description})
+	require.NoError(t, err)
+
+	entries, _ := repoService.Audit.ListByRepo(context.TODO(), repo.ID.Hex())
+	require.Len(t, entries, 2)
+	assert.Equal(t, map[string]string{"from": "tools", "to": "kit"}, entries[0].Details)
+	assert.Equal(t, map[string]string{"from": "kit", "to": "platform"}, entries[1].Details)
+	assert.Equal(t, "owner", entries[1].ActorID)
+}
+
+func TestRename_FormerNameIsReserved(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	_, err = repoService.Rename(as("owner"), repo.ID.Hex(), "platform", false)
+	require.NoError(t, err)
+
+	_, err = repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	assert.Equal(t, "name", repoerr.ViolationsOf(err)[0].Field)
+
+	// Other owners are not affected
+	_, err = repoService.Create(as("someone"), &public_repo.CreateRepoModel{Name: "tools"})
+	assert.Nil(t, err)
+
+	replacement, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools", ReuseName: true})
+	require.NoError(t, err)
+
+	found, err := repoService.FindByFullName(as("owner"), "owner/tools")
+	require.NoError(t, err)
+	assert.Equal(t, replacement.ID, found.ID)
+	assert.Empty(t, found.RedirectedFrom)
+}
+
+func TestRename_BackToFormerName(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	_, err = repoService.Rename(as("owner"), repo.ID.Hex(), "platform", false)
+	require.NoError(t, err)
+
+	// A repo may always take back its own former name
+	renamed, err := repoService.Patch(as("owner"), repo.ID.Hex(), &model.RepoPatch{Name: &repo.Name})
+	require.NoError(t, err)
+	assert.Equal(t, "tools", renamed.Name)
+	assert.Equal(t, []string{"tools", "platform"}, renamed.PreviousNames)
+
+	found, err := repoService.FindByFullName(as("owner"), "owner/platform")
+	require.NoError(t, err)
+	assert.Equal(t, repo.ID, found.ID)
+}
+
+func TestRename_Error(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	_, err = repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "other"})
+	require.NoError(t, err)
+
+	_, err = repoService.Rename(as("owner"), repo.ID.Hex(), "other", false)
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+
+	_, err = repoService.Rename(as("owner"), repo.ID.Hex(), " ", false)
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+
+	_, err = repoService.Rename(as("someone"), repo.ID.Hex(), "mine", false)
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+}
@@ -2,11 +2,19 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/authz"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
 	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/naming"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
 	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
 	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -16,64 +24,837 @@ type RepoService interface {
 	Create(ctx context.Context, repo *public_repo.CreateRepoModel) (*model.PrivateRepoModel, error)
 	FindById(ctx context.Context, id string) (*model.PrivateRepoModel, error)
 	FindByName(ctx context.Context, name string) (*model.PrivateRepoModel, error)
+	FindByFullName(ctx context.Context, fullName string) (*model.PrivateRepoModel, error)
 	FindByFindByIdentifier(ctx context.Context, identifier string) (*model.PrivateRepoModel, error)
 	Update(ctx context.Context, repo *model.PrivateRepoModel) (*model.PrivateRepoModel, error)
+	Patch(ctx context.Context, id string, patch *model.RepoPatch) (*model.PrivateRepoModel, error)
+	Rename(ctx context.Context, id string, name string, reuseName bool) (*model.PrivateRepoModel, error)
+	SetVisibility(ctx context.Context, id string, visibility string) (*model.PrivateRepoModel, error)
+	Transfer(ctx context.Context, id string, transfer *model.RepoTransfer) (*model.PrivateRepoModel, error)
+	ValidateName(ctx context.Context, name string) (string, error)
 	Delete(ctx context.Context, repo *model.PrivateRepoModel) error
+	Restore(ctx context.Context, id string) (*model.PrivateRepoModel, error)
+	Archive(ctx context.Context, id string) (*model.PrivateRepoModel, error)
+	Unarchive(ctx context.Context, id string) (*model.PrivateRepoModel, error)
+	List(ctx context.Context, query *model.RepoListQuery) (*model.RepoPage, error)
 }
 
+const (
+	DefaultListLimit = 30
+	MaxListLimit     = 100
+)
+
+const (
+	MaxTopics             = 20
+	MaxTopicLength        = 50
+	MaxProperties         = 50
+	MaxPropertyNameLength = 64
+	MaxPropertyLength     = 256
+)
+
+var (
+	topicPattern        = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+	propertyNamePattern = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+)
+
+// DefaultTrashRetention is how long deleted repos can be restored unless configured otherwise
+const DefaultTrashRetention = 30 * 24 * time.Hour
+
 type RepoServiceImpl struct {
-	Repository repository.RepoRepository
+	Repository     repository.RepoRepository
+	Audit          repository.AuditRepository
+	Collaborators  repository.CollaboratorRepository
+	Organizations  repository.OrganizationRepository
+	Teams          repository.TeamRepository
+	Redirects      repository.RedirectRepository
+	Subscriptions  repository.SubscriptionRepository
+	Policy         *naming.Policy
+	Authorizer     authz.Policy  // Consulted before every change to a repo
+	TrashRetention time.Duration // How long deleted repos can be restored before they are purged
 }
 
-func NewRepoService(repository repository.RepoRepository) *RepoServiceImpl {
+// NewRepoService keeps audit entries, collaborators, organizations, teams,
+// redirects and subscriptions in memory until durable stores are set, the
+// Authorizer has to be replaced along with them
+func NewRepoService(repoRepository repository.RepoRepository) *RepoServiceImpl {
+	collaborators := repository.NewMemoryCollaboratorRepository()
+	organizations := repository.NewMemoryOrganizationRepository()
+	teams := repository.NewMemoryTeamRepository()
+
 	return &RepoServiceImpl{
-		Repository: repository,
+		Repository:     repoRepository,
+		Audit:          repository.NewMemoryAuditRepository(),
+		Collaborators:  collaborators,
+		Organizations:  organizations,
+		Teams:          teams,
+		Redirects:      repository.NewMemoryRedirectRepository(),
+		Subscriptions:  repository.NewMemorySubscriptionRepository(),
+		Policy:         naming.DefaultPolicy(),
+		Authorizer:     authz.NewRolePolicy(collaborators, organizations, teams),
+		TrashRetention: DefaultTrashRetention,
 	}
 }
 
+// Create makes a repo owned by the authenticated caller, or by the organization
+// named in the model if the caller is one of its members. A repo created from a
+// template starts out as a copy of it, see fromTemplate.
 func (s *RepoServiceImpl) Create(ctx context.Context, repo *public_repo.CreateRepoModel) (*model.PrivateRepoModel, error) {
-	repo.Name = normalizeRepoName(repo.Name)
-	privateRepo := &model.PrivateRepoModel{
+	if repo.Template != "" {
+		return s.fromTemplate(ctx, repo)
+	}
+
+	privateRepo, err := s.newRepo(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.insert(ctx, privateRepo)
+}
+
+// newRepo checks a repo the caller wants to create and builds it without storing it
+func (s *RepoServiceImpl) newRepo(ctx context.Context, repo *public_repo.CreateRepoModel) (*model.PrivateRepoModel, error) {
+	caller, ok := identity.FromContext(ctx)
+	if !ok || caller.ID == "" {
+		return nil, repoerr.Unauthenticated("sign in to create a repo")
+	}
+
+	ownerID, ownerType := caller.ID, model.OwnerUser
+	if repo.Organization != "" {
+		org, err := s.memberOrganization(ctx, repo.Organization, caller.ID)
+		if err != nil {
+			return nil, err
+		}
+		ownerID, ownerType = org.ID.Hex(), model.OwnerOrganization
+	}
+
+	name, err := s.checkName(repo.Name)
+	if err != nil {
+		return nil, err
+	}
+	skeleton, err := s.checkSimilar(ctx, ownerID, name, primitive.NilObjectID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkFormerName(ctx, ownerID, name, primitive.NilObjectID, repo.ReuseName); err != nil {
+		return nil, err
+	}
+	visibility := repo.Visibility
+	if visibility == "" {
+		visibility = model.VisibilityPublic
+	}
+	if err := validateVisibility(visibility); err != nil {
+		return nil, err
+	}
+	topics, err := checkTopics(repo.Topics)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateProperties(repo.Properties); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
+	return &model.PrivateRepoModel{
 		ID:          primitive.NewObjectID(),
-		Name:        normalizeRepoName(repo.Name),
+		Name:        name,
 		Description: repo.Description,
-		OwnerID:     repo.OwnerID,
-		CreatedAt:   time.Now(),
-		UpdatedAt:   time.Now(),
+		OwnerID:     ownerID,
+		OwnerType:   ownerType,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+		Version:     1,
+		Skeleton:    skeleton,
+		Visibility:  visibility,
+		Topics:      topics,
+		Properties:  repo.Properties,
+	}, nil
+}
+
+// insert stores a repo built by newRepo, taking over a redirect at its address
+func (s *RepoServiceImpl) insert(ctx context.Context, repo *model.PrivateRepoModel) (*model.PrivateRepoModel, error) {
+	created, err := s.Repository.Create(ctx, repo)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.Repository.Create(ctx, privateRepo)
+	if err := s.Redirects.Remove(ctx, created.OwnerID, created.Name); err != nil {
+		return nil, fmt.Errorf("remove redirect: %w", err)
+	}
+
+	return created, nil
 }
 
 func (s *RepoServiceImpl) FindById(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
-	return s.Repository.FindById(ctx, id)
+	repo, err := s.Repository.FindById(ctx, id)
+	return s.visible(ctx, repo, err)
 }
 
+// FindByName looks a repo up by its name alone. Names are only unique per owner, so
+// this works as long as the caller can see a single repo of that name; otherwise
+// it has to be looked up by "owner/name". Former names do not resolve without an
+// owner either.
 func (s *RepoServiceImpl) FindByName(ctx context.Context, name string) (*model.PrivateRepoModel, error) {
-	return s.Repository.FindByName(ctx, name)
+	access, err := s.accessFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.Repository.List(ctx, &model.RepoListQuery{Name: naming.Normalize(name), Access: access, Limit: 2})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(page.Repos) {
+	case 0:
+		return nil, repoerr.NotFound("repo not found")
+	case 1:
+		return page.Repos[0], nil
+	}
+
+	return nil, repoerr.Conflict("several repos are named %q, look it up by owner/name", name)
+}
+
+// FindByFullName looks a repo up by its "owner/name" address, where owner is an
+// organization's login or a user ID. Former addresses of renamed or transferred
+// repos resolve to them as long as no other repo took them over.
+func (s *RepoServiceImpl) FindByFullName(ctx context.Context, fullName string) (*model.PrivateRepoModel, error) {
+	owner, name, ok := strings.Cut(fullName, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return nil, repoerr.NotFound("repo not found")
+	}
+
+	ownerID, err := s.resolveOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := s.Repository.FindByOwnerAndName(ctx, ownerID, name)
+	if errors.Is(err, repoerr.ErrNotFound) {
+		redirect, err := s.Redirects.Find(ctx, ownerID, name)
+		repo, err = s.followRedirect(ctx, redirect, err, fullName)
+		return s.visible(ctx, repo, err)
+	}
+	return s.visible(ctx, repo, err)
 }
 
 func (s *RepoServiceImpl) FindByFindByIdentifier(ctx context.Context, identifier string) (*model.PrivateRepoModel, error) {
+	if strings.Contains(identifier, "/") {
+		return s.FindByFullName(ctx, identifier)
+	}
+
 	_, err := primitive.ObjectIDFromHex(identifier)
 	if err == nil {
-		return s.Repository.FindById(ctx, identifier)
+		return s.FindById(ctx, identifier)
 	}
 
-	return s.Repository.FindByName(ctx, identifier)
+	return s.FindByName(ctx, identifier)
 }
 
+// Update saves the mutable fields of repo. The caller is authorized against the
+// stored repo, whose owner is kept. A new name is handled like Rename.
 func (s *RepoServiceImpl) Update(ctx context.Context, repo *model.PrivateRepoModel) (*model.PrivateRepoModel, error) {
-	return s.Repository.UpdateOne(ctx, repo)
+	name, err := s.checkName(repo.Name)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := s.FindById(ctx, repo.ID.Hex())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorize(ctx, authz.ActionUpdate, stored); err != nil {
+		return nil, err
+	}
+	before := *stored
+	repo.OwnerID = stored.OwnerID
+	repo.OwnerType = stored.OwnerType
+	skeleton, err := s.checkSimilar(ctx, repo.OwnerID, name, repo.ID)
+	if err != nil {
+		return nil, err
+	}
+	renamed := name != before.Name
+	if renamed {
+		if err := s.checkFormerName(ctx, repo.OwnerID, name, repo.ID, false); err != nil {
+			return nil, err
+		}
+		repo.PreviousNames = before.PreviousNames
+		if !before.HadName(before.Name) {
+			repo.PreviousNames = append(append([]string{}, before.PreviousNames...), before.Name)
+		}
+	}
+	repo.Name = name
+	repo.Skeleton = skeleton
+	repo.UpdatedAt = time.Now()
+
+	updated, err := s.Repository.UpdateOne(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	if renamed {
+		if err := s.finishRename(ctx, &before, updated); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
 }
 
+// Patch updates only the fields named in the patch, UpdatedAt is always bumped. A new
+// name is handled like Rename.
+func (s *RepoServiceImpl) Patch(ctx context.Context, id string, patch *model.RepoPatch) (*model.PrivateRepoModel, error) {
+	if patch.Name != nil {
+		name, err := s.checkNewName(*patch.Name)
+		if err != nil {
+			return nil, err
+		}
+		patch.Name = &name
+	}
+	if patch.Topics != nil {
+		topics, err := checkTopics(*patch.Topics)
+		if err != nil {
+			return nil, err
+		}
+		patch.Topics = &topics
+	}
+	if patch.Properties != nil {
+		if err := validateProperties(*patch.Properties); err != nil {
+			return nil, err
+		}
+	}
+
+	repo, err := s.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorize(ctx, authz.ActionUpdate, repo); err != nil {
+		return nil, err
+	}
+
+	return s.patch(ctx, repo, patch)
+}
+
+// Rename gives a repo a new name, the old one redirects to it from then on and the
+// rename is audited. Former names of other repos of the owner are only taken over
+// with reuseName, which ends their redirect.
+func (s *RepoServiceImpl) Rename(ctx context.Context, id string, name string, reuseName bool) (*model.PrivateRepoModel, error) {
+	name, err := s.checkNewName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := s.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorize(ctx, authz.ActionUpdate, repo); err != nil {
+		return nil, err
+	}
+	if name == repo.Name {
+		return repo, nil
+	}
+
+	return s.patch(ctx, repo, &model.RepoPatch{Name: &name, ReuseName: reuseName, Version: repo.Version})
+}
+
+// patch applies a patch, whose name is already normalized, to a repo the caller may
+// update. A new name is handled like Rename.
+func (s *RepoServiceImpl) patch(ctx context.Context, repo *model.PrivateRepoModel, patch *model.RepoPatch) (*model.PrivateRepoModel, error) {
+	renamed := false
+	if patch.Name != nil {
+		var err error
+		patch.Skeleton, err = s.checkSimilar(ctx, repo.OwnerID, *patch.Name, repo.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if renamed = *patch.Name != repo.Name; renamed {
+			if err := s.checkFormerName(ctx, repo.OwnerID, *patch.Name, repo.ID, patch.ReuseName); err != nil {
+				return nil, err
+			}
+			patch.PreviousName = repo.Name
+		}
+	}
+	patch.UpdatedAt = time.Now()
+
+	updated, err := s.Repository.PatchOne(ctx, repo.ID.Hex(), patch)
+	if err != nil {
+		return nil, err
+	}
+
+	if renamed {
+		if err := s.finishRename(ctx, repo, updated); err != nil {
+			return nil, err
+		}
+	}
+
+	return updated, nil
+}
+
+// SetVisibility changes who can see a repo, every change is audited. Forks that end
+// up more visible than their parent are split off into a fork network of their own.
+func (s *RepoServiceImpl) SetVisibility(ctx context.Context, id string, visibility string) (*model.PrivateRepoModel, error) {
+	if err := validateVisibility(visibility); err != nil {
+		return nil, err
+	}
+
+	repo, err := s.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	caller, err := s.authorize(ctx, authz.ActionSetVisibility, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := repo.EffectiveVisibility()
+	if previous == visibility {
+		return repo, nil
+	}
+
+	updated, err := s.Repository.PatchOne(ctx, id, &model.RepoPatch{
+		Visibility: &visibility,
+		Version:    repo.Version,
+		UpdatedAt:  time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Audit.Record(ctx, &model.AuditEntry{
+		RepoID:    repo.ID,
+		ActorID:   caller.ID,
+		Action:    model.AuditVisibilityChanged,
+		Details:   map[string]string{"from": previous, "to": visibility},
+		CreatedAt: updated.UpdatedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("record visibility change: %w", err)
+	}
+
+	return s.splitNetwork(ctx, updated)
+}
+
+// ValidateName is a dry run of the name checks done on create, it returns the name
+// as it would be stored. For an authenticated caller it also reports names their
+// repos already use.
+func (s *RepoServiceImpl) ValidateName(ctx context.Context, name string) (string, error) {
+	normalized, err := s.checkName(name)
+	caller, _ := identity.FromContext(ctx)
+	if err != nil || caller.ID == "" {
+		return normalized, err
+	}
+
+	_, err = s.Repository.FindByOwnerAndName(ctx, caller.ID, normalized)
+	switch {
+	case err == nil:
+		return normalized, repoerr.Validation(repoerr.Violation{Field: "name", Message: "is already taken"})
+	case !errors.Is(err, repoerr.ErrNotFound):
+		return normalized, err
+	}
+
+	_, err = s.checkSimilar(ctx, caller.ID, normalized, primitive.NilObjectID)
+	return normalized, err
+}
+
+// Delete moves a repo to the trash. It keeps its name, collaborators and grants
+// until it is restored or purged once TrashRetention ran out.
 func (s *RepoServiceImpl) Delete(ctx context.Context, repo *model.PrivateRepoModel) error {
-	return s.Repository.DeleteOne(ctx, repo)
+	stored, err := s.FindById(ctx, repo.ID.Hex())
+	if err != nil {
+		return err
+	}
+	caller, err := s.authorize(ctx, authz.ActionDelete, stored)
+	if err != nil {
+		return err
+	}
+
+	trashed, err := s.Repository.Trash(ctx, stored.ID.Hex(), time.Now())
+	if err != nil {
+		return err
+	}
+
+	if err := s.countFork(ctx, trashed, -1); err != nil {
+		return err
+	}
+
+	err = s.Audit.Record(ctx, &model.AuditEntry{
+		RepoID:    trashed.ID,
+		ActorID:   caller.ID,
+		Action:    model.AuditRepoDeleted,
+		Details:   map[string]string{"purgeAt": s.purgeAt(trashed).UTC().Format(time.RFC3339)},
+		CreatedAt: *trashed.DeletedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("record delete: %w", err)
+	}
+
+	return nil
 }
 
-func normalizeRepoName(name string) string {
-	name = strings.ReplaceAll(name, " ", "-")
-	name = strings.ToLower(name)
-	re := regexp.MustCompile(`-{2,}`)
-	name = re.ReplaceAllString(name, "-")
-	return name
+func (s *RepoServiceImpl) List(ctx context.Context, query *model.RepoListQuery) (*model.RepoPage, error) {
+	access, err := s.accessFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query.Access = access
+	if query.OwnerID != "" {
+		if query.OwnerID, err = s.resolveOwner(ctx, query.OwnerID); err != nil {
+			return nil, err
+		}
+	}
+	// Names are stored normalized, so the prefix has to be too
+	query.NamePrefix = naming.Normalize(query.NamePrefix)
+	violations := []repoerr.Violation{}
+
+	switch query.SortBy {
+	case "":
+		query.SortBy = model.SortByCreatedAt
+	case model.SortByCreatedAt, model.SortByUpdatedAt, model.SortByName, model.SortByStars, model.SortByWatchers:
+	default:
+		violations = append(violations, repoerr.Violation{Field: "sort", Message: "must be one of created_at, updated_at, name, stars_count, watchers_count"})
+	}
+
+	if violation := checkLimit(&query.Limit); violation != nil {
+		violations = append(violations, *violation)
+	}
+
+	if len(violations) > 0 {
+		return nil, repoerr.Validation(violations...)
+	}
+
+	return s.Repository.List(ctx, query)
+}
+
+// checkLimit defaults a zero page size and rejects one out of range
+func checkLimit(limit *int) *repoerr.Violation {
+	switch {
+	case *limit == 0:
+		*limit = DefaultListLimit
+	case *limit < 0 || *limit > MaxListLimit:
+		return &repoerr.Violation{Field: "limit", Message: fmt.Sprintf("must be between 1 and %d", MaxListLimit)}
+	}
+
+	return nil
+}
+
+// accessFor describes what the caller in ctx may see: anonymous callers only public
+// repos, authenticated ones internal repos and the private ones they own, collaborate
+// on or whose organization they belong to as well. Team members always belong to the
+// team's organization, so teams grant no extra visibility. Admins see every repo.
+func (s *RepoServiceImpl) accessFor(ctx context.Context) (*model.RepoAccess, error) {
+	caller, _ := identity.FromContext(ctx)
+	if isAdmin(caller) {
+		return nil, nil
+	}
+	access := baseAccess(caller)
+	if caller.ID == "" {
+		return access, nil
+	}
+
+	repoIDs, err := s.Collaborators.ListRepoIDsByUser(ctx, caller.ID)
+	if err != nil {
+		return nil, err
+	}
+	access.RepoIDs = repoIDs
+
+	orgIDs, err := s.Organizations.ListIDsByMember(ctx, caller.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range orgIDs {
+		access.OrgIDs = append(access.OrgIDs, id.Hex())
+	}
+
+	return access, nil
+}
+
+// baseAccess is accessFor without the collaborations
+func baseAccess(caller identity.Caller) *model.RepoAccess {
+	if caller.ID == "" {
+		return &model.RepoAccess{Visibilities: []string{model.VisibilityPublic}}
+	}
+
+	return &model.RepoAccess{
+		Visibilities: []string{model.VisibilityPublic, model.VisibilityInternal},
+		MemberID:     caller.ID,
+	}
+}
+
+// authorize asks the policy whether the caller in ctx may perform action on repo
+// and returns the caller. Archived repos additionally refuse every action but the
+// archivedActions.
+func (s *RepoServiceImpl) authorize(ctx context.Context, action authz.Action, repo *model.PrivateRepoModel) (identity.Caller, error) {
+	caller, _ := identity.FromContext(ctx)
+	if err := s.Authorizer.Authorize(ctx, caller, action, repo); err != nil {
+		return caller, err
+	}
+
+	if repo.Archived() && !archivedActions[action] {
+		return caller, repoerr.ReadOnly("repo is archived and read-only, unarchive it first")
+	}
+
+	return caller, nil
+}
+
+// visible turns repos the caller may not see into NotFound, so their existence
+// does not leak
+func (s *RepoServiceImpl) visible(ctx context.Context, repo *model.PrivateRepoModel, err error) (*model.PrivateRepoModel, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	caller, _ := identity.FromContext(ctx)
+	if isAdmin(caller) || baseAccess(caller).Allows(repo) {
+		return repo, nil
+	}
+
+	if caller.ID != "" {
+		member, err := s.isMember(ctx, caller.ID, repo)
+		switch {
+		case err != nil:
+			return nil, err
+		case member:
+			return repo, nil
+		}
+	}
+
+	return nil, repoerr.NotFound("repo not found")
+}
+
+// isAdmin reports whether the token grants caller the platform wide admin role
+func isAdmin(caller identity.Caller) bool {
+	return caller.ID != "" && caller.HasRole(string(authz.RoleAdmin))
+}
+
+// isMember reports whether userID collaborates on repo or belongs to the
+// organization owning it
+func (s *RepoServiceImpl) isMember(ctx context.Context, userID string, repo *model.PrivateRepoModel) (bool, error) {
+	_, err := s.Collaborators.Find(ctx, repo.ID, userID)
+	if found, err := exists(err); found || err != nil {
+		return found, err
+	}
+
+	orgID, ok := repo.OrganizationID()
+	if !ok {
+		return false, nil
+	}
+
+	_, err = s.Organizations.FindMember(ctx, orgID, userID)
+	return exists(err)
+}
+
+// exists turns the error of a lookup into whether something was found, only errors
+// other than NotFound are returned
+func exists(err error) (bool, error) {
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, repoerr.ErrNotFound):
+		return false, nil
+	}
+
+	return false, err
+}
+
+// finishRename completes every rename, whichever way it was asked for: the old name
+// redirects to the repo and the caller in ctx is audited as renaming it
+func (s *RepoServiceImpl) finishRename(ctx context.Context, before *model.PrivateRepoModel, after *model.PrivateRepoModel) error {
+	if err := s.moveAddress(ctx, before, after); err != nil {
+		return err
+	}
+
+	caller, _ := identity.FromContext(ctx)
+	err := s.Audit.Record(ctx, &model.AuditEntry{
+		RepoID:    before.ID,
+		ActorID:   caller.ID,
+		Action:    model.AuditRepoRenamed,
+		Details:   map[string]string{"from": before.Name, "to": after.Name},
+		CreatedAt: after.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("record rename: %w", err)
+	}
+
+	return nil
+}
+
+// moveAddress leaves a redirect at the address the repo had before, and drops one
+// the repo now answers for itself
+func (s *RepoServiceImpl) moveAddress(ctx context.Context, before *model.PrivateRepoModel, after *model.PrivateRepoModel) error {
+	err := s.Redirects.Save(ctx, &model.Redirect{
+		OwnerID:   before.OwnerID,
+		Name:      before.Name,
+		RepoID:    before.ID,
+		CreatedAt: after.UpdatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("save redirect: %w", err)
+	}
+
+	if err := s.Redirects.Remove(ctx, after.OwnerID, after.Name); err != nil {
+		return fmt.Errorf("remove redirect: %w", err)
+	}
+
+	return nil
+}
+
+// followRedirect finds the repo a redirect looked up by from points to, a missing
+// redirect is reported as a missing repo
+func (s *RepoServiceImpl) followRedirect(ctx context.Context, redirect *model.Redirect, err error, from string) (*model.PrivateRepoModel, error) {
+	if errors.Is(err, repoerr.ErrNotFound) {
+		return nil, repoerr.NotFound("repo not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := s.Repository.FindById(ctx, redirect.RepoID.Hex())
+	if err != nil {
+		return nil, err
+	}
+	repo.RedirectedFrom = from
+
+	return repo, nil
+}
+
+// resolveOwner turns the owner part of a full name into the OwnerID of its repos.
+// Organization logins take precedence over user IDs, CreateOrganization refuses
+// logins that are user IDs already.
+func (s *RepoServiceImpl) resolveOwner(ctx context.Context, owner string) (string, error) {
+	org, err := s.Organizations.FindByLogin(ctx, owner)
+	switch {
+	case err == nil:
+		return org.ID.Hex(), nil
+	case errors.Is(err, repoerr.ErrNotFound):
+		return owner, nil
+	}
+
+	return "", err
+}
+
+func validateVisibility(visibility string) error {
+	switch visibility {
+	case model.VisibilityPublic, model.VisibilityInternal, model.VisibilityPrivate:
+		return nil
+	}
+
+	return repoerr.Validation(repoerr.Violation{Field: "visibility", Message: "must be one of public, internal, private"})
+}
+
+// checkTopics lowercases topics and drops repeated ones
+func checkTopics(topics []string) ([]string, error) {
+	checked := []string{}
+	seen := map[string]bool{}
+	for _, topic := range topics {
+		topic = strings.ToLower(strings.TrimSpace(topic))
+		if !topicPattern.MatchString(topic) || len(topic) > MaxTopicLength {
+			return nil, repoerr.Validation(repoerr.Violation{
+				Field:   "topics",
+				Message: fmt.Sprintf("%q must be at most %d lowercase letters, digits or hyphens, not starting with a hyphen", topic, MaxTopicLength),
+			})
+		}
+		if !seen[topic] {
+			seen[topic] = true
+			checked = append(checked, topic)
+		}
+	}
+
+	if len(checked) > MaxTopics {
+		return nil, repoerr.Validation(repoerr.Violation{Field: "topics", Message: fmt.Sprintf("must be at most %d", MaxTopics)})
+	}
+	if len(checked) == 0 {
+		return nil, nil
+	}
+
+	return checked, nil
+}
+
+func validateProperties(properties map[string]string) error {
+	if len(properties) > MaxProperties {
+		return repoerr.Validation(repoerr.Violation{Field: "properties", Message: fmt.Sprintf("must be at most %d", MaxProperties)})
+	}
+
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	violations := []repoerr.Violation{}
+	for _, name := range names {
+		value := properties[name]
+		if !propertyNamePattern.MatchString(name) || len(name) > MaxPropertyNameLength {
+			violations = append(violations, repoerr.Violation{
+				Field:   "properties",
+				Message: fmt.Sprintf("name %q must be at most %d letters, digits, '_', '.' or '-'", name, MaxPropertyNameLength),
+			})
+		}
+		if utf8.RuneCountInString(value) > MaxPropertyLength {
+			violations = append(violations, repoerr.Violation{Field: "properties." + name, Message: fmt.Sprintf("must be at most %d characters", MaxPropertyLength)})
+		}
+	}
+
+	if len(violations) > 0 {
+		return repoerr.Validation(violations...)
+	}
+
+	return nil
+}
+
+// checkName normalizes a name and validates it against the naming policy
+func (s *RepoServiceImpl) checkName(name string) (string, error) {
+	name = naming.Normalize(name)
+	return name, s.Policy.Validate(name)
+}
+
+// checkNewName is checkName for a name replacing another, which must not be blank
+func (s *RepoServiceImpl) checkNewName(name string) (string, error) {
+	if strings.TrimSpace(name) == "" {
+		return "", repoerr.Validation(repoerr.Violation{Field: "name", Message: "is required"})
+	}
+
+	return s.checkName(name)
+}
+
+// checkFormerName keeps the former names of the owner's repos reserved for their
+// redirects, unless reuse says to take the name over
+func (s *RepoServiceImpl) checkFormerName(ctx context.Context, ownerID string, name string, id primitive.ObjectID, reuse bool) error {
+	if reuse {
+		return nil
+	}
+
+	redirect, err := s.Redirects.Find(ctx, ownerID, name)
+	switch {
+	case errors.Is(err, repoerr.ErrNotFound):
+		return nil
+	case err != nil:
+		return err
+	case redirect.RepoID == id:
+		return nil
+	}
+
+	return repoerr.Validation(repoerr.Violation{Field: "name", Message: "is a former name of another repo, reuse it explicitly to end its redirect"})
+}
+
+// checkSimilar rejects a name that is confusable with another repo of the same owner
+// and returns its skeleton. Identical names are left to the repository's conflict check.
+func (s *RepoServiceImpl) checkSimilar(ctx context.Context, ownerID string, name string, id primitive.ObjectID) (string, error) {
+	skeleton := naming.Skeleton(name)
+
+	similar, err := s.Repository.FindByOwnerAndSkeleton(ctx, ownerID, skeleton)
+	switch {
+	case errors.Is(err, repoerr.ErrNotFound):
+		return skeleton, nil
+	case err != nil:
+		return "", err
+	case similar.ID == id:
+		return skeleton, nil
+	case similar.Trashed():
+		return "", repoerr.Validation(repoerr.Violation{Field: "name", Message: fmt.Sprintf("is reserved by deleted repo %q until it is purged", similar.Name)})
+	case similar.Name == name:
+		return skeleton, nil
+	}
+
+	return "", repoerr.Validation(repoerr.Violation{Field: "name", Message: fmt.Sprintf("is too similar to existing repo %q", similar.Name)})
 }
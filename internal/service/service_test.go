@@ -3,12 +3,18 @@ package service_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/authz"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
 	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
 	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
 	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -16,6 +22,16 @@ type RepositoryMock struct {
 	mock.Mock
 }
 
+func (r *RepositoryMock) FindByOwnerAndName(ctx context.Context, ownerID string, name string) (*model.PrivateRepoModel, error) {
+	args := r.Called(ctx, ownerID, name)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (r *RepositoryMock) FindByOwnerAndSkeleton(ctx context.Context, ownerID string, skeleton string) (*model.PrivateRepoModel, error) {
+	args := r.Called(ctx, ownerID, skeleton)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
 func (r *RepositoryMock) Create(ctx context.Context, repo *model.PrivateRepoModel) (*model.PrivateRepoModel, error) {
 	args := r.Called(ctx, repo)
 	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
@@ -36,23 +52,95 @@ func (r *RepositoryMock) UpdateOne(ctx context.Context, repo *model.PrivateRepoM
 	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
 }
 
+func (r *RepositoryMock) PatchOne(ctx context.Context, id string, patch *model.RepoPatch) (*model.PrivateRepoModel, error) {
+	args := r.Called(ctx, id, patch)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
 func (r *RepositoryMock) DeleteOne(ctx context.Context, repo *model.PrivateRepoModel) error {
 	args := r.Called(ctx, repo)
 	return args.Error(0)
 }
 
+func (r *RepositoryMock) List(ctx context.Context, query *model.RepoListQuery) (*model.RepoPage, error) {
+	args := r.Called(ctx, query)
+	return args.Get(0).(*model.RepoPage), args.Error(1)
+}
+
+func (r *RepositoryMock) Trash(ctx context.Context, id string, deletedAt time.Time) (*model.PrivateRepoModel, error) {
+	args := r.Called(ctx, id, deletedAt)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (r *RepositoryMock) Restore(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	args := r.Called(ctx, id)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (r *RepositoryMock) FindTrashedById(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	args := r.Called(ctx, id)
+	return args.Get(0).(*model.PrivateRepoModel), args.Error(1)
+}
+
+func (r *RepositoryMock) ListTrashed(ctx context.Context, deletedBefore time.Time) ([]*model.PrivateRepoModel, error) {
+	args := r.Called(ctx, deletedBefore)
+	return args.Get(0).([]*model.PrivateRepoModel), args.Error(1)
+}
+
+func (r *RepositoryMock) Count(ctx context.Context, query *model.RepoListQuery) (int64, error) {
+	args := r.Called(ctx, query)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (r *RepositoryMock) AddForks(ctx context.Context, id string, delta int) error {
+	args := r.Called(ctx, id, delta)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) SetForks(ctx context.Context, id string, count int64) error {
+	args := r.Called(ctx, id, count)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) SetStars(ctx context.Context, id string, count int64) error {
+	args := r.Called(ctx, id, count)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) SetWatchers(ctx context.Context, id string, count int64) error {
+	args := r.Called(ctx, id, count)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) AddStars(ctx context.Context, id string, delta int) error {
+	args := r.Called(ctx, id, delta)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) AddWatchers(ctx context.Context, id string, delta int) error {
+	args := r.Called(ctx, id, delta)
+	return args.Error(0)
+}
+
+func (r *RepositoryMock) SetForkLinks(ctx context.Context, id string, parentID *primitive.ObjectID, rootID *primitive.ObjectID) error {
+	args := r.Called(ctx, id, parentID, rootID)
+	return args.Error(0)
+}
+
 func TestCreate_Success(t *testing.T) {
-	ctx := context.TODO()
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "owner"})
 	repositoryMock := new(RepositoryMock)
 
 	service := service.NewRepoService(repositoryMock)
 	repoToBeCreated := &public_repo.CreateRepoModel{
 		Name:        "Test",
 		Description: "Test",
-		OwnerID:     primitive.NewObjectID().Hex(),
 	}
 
-	repositoryMock.On("Create", ctx, mock.Anything).Return(&model.PrivateRepoModel{}, nil)
+	repositoryMock.On("FindByOwnerAndSkeleton", mock.Anything, mock.Anything, mock.Anything).Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
+	repositoryMock.On("Create", ctx, mock.MatchedBy(func(repo *model.PrivateRepoModel) bool {
+		return repo.OwnerID == "owner"
+	})).Return(&model.PrivateRepoModel{}, nil)
 
 	_, err := service.Create(ctx, repoToBeCreated)
 
@@ -60,16 +148,16 @@ func TestCreate_Success(t *testing.T) {
 }
 
 func TestCreate_Error(t *testing.T) {
-	ctx := context.TODO()
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "owner"})
 	repositoryMock := new(RepositoryMock)
 
 	service := service.NewRepoService(repositoryMock)
 	repoToBeCreated := &public_repo.CreateRepoModel{
 		Name:        "Test",
 		Description: "Test",
-		OwnerID:     primitive.NewObjectID().Hex(),
 	}
 
+	repositoryMock.On("FindByOwnerAndSkeleton", mock.Anything, mock.Anything, mock.Anything).Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
 	repositoryMock.On("Create", ctx, mock.Anything).Return(&model.PrivateRepoModel{}, assert.AnError)
 
 	_, err := service.Create(ctx, repoToBeCreated)
@@ -116,13 +204,16 @@ func TestFindByName_Success(t *testing.T) {
 	repositoryMock := new(RepositoryMock)
 
 	service := service.NewRepoService(repositoryMock)
-	name := "Test"
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: "test"}
 
-	repositoryMock.On("FindByName", ctx, mock.Anything).Return(&model.PrivateRepoModel{}, nil)
+	repositoryMock.On("List", ctx, mock.MatchedBy(func(query *model.RepoListQuery) bool {
+		return query.Name == "test" && query.Access != nil
+	})).Return(&model.RepoPage{Repos: []*model.PrivateRepoModel{repo}}, nil)
 
-	_, err := service.FindByName(ctx, name)
+	found, err := service.FindByName(ctx, "Test")
 
 	assert.Nil(t, err)
+	assert.Equal(t, repo, found)
 
 	repositoryMock.AssertExpectations(t)
 }
@@ -134,7 +225,7 @@ func TestFindByName_Error(t *testing.T) {
 	service := service.NewRepoService(repositoryMock)
 	name := "Test"
 
-	repositoryMock.On("FindByName", ctx, mock.Anything).Return(&model.PrivateRepoModel{}, assert.AnError)
+	repositoryMock.On("List", ctx, mock.Anything).Return((*model.RepoPage)(nil), assert.AnError)
 
 	_, err := service.FindByName(ctx, name)
 
@@ -143,6 +234,37 @@ func TestFindByName_Error(t *testing.T) {
 	repositoryMock.AssertExpectations(t)
 }
 
+func TestFindByName_Error_Ambiguous(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	for _, owner := range []string{"alice", "bob"} {
+		_, err := repoService.Create(as(owner), &public_repo.CreateRepoModel{Name: "tools"})
+		require.NoError(t, err)
+	}
+
+	_, err := repoService.FindByName(as("someone"), "tools")
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+
+	found, err := repoService.FindByFullName(as("someone"), "bob/tools")
+	require.NoError(t, err)
+	assert.Equal(t, "bob", found.OwnerID)
+}
+
+// A private repo of the same name does not hide the one the caller can see
+func TestFindByName_IgnoresHiddenRepos(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	_, err := repoService.Create(as("alice"), &public_repo.CreateRepoModel{Name: "tools", Visibility: model.VisibilityPrivate})
+	require.NoError(t, err)
+	visible, err := repoService.Create(as("bob"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+
+	found, err := repoService.FindByName(as("someone"), "tools")
+	require.NoError(t, err)
+	assert.Equal(t, visible.ID, found.ID)
+
+	_, err = repoService.FindByName(as("alice"), "tools")
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+}
+
 func TestFindByFindByIdentifier_Id(t *testing.T) {
 	ctx := context.TODO()
 	repositoryMock := new(RepositoryMock)
@@ -166,7 +288,7 @@ func TestFindByFindByIdentifier_Name(t *testing.T) {
 	service := service.NewRepoService(repositoryMock)
 	name := "Test"
 
-	repositoryMock.On("FindByName", ctx, mock.Anything).Return(&model.PrivateRepoModel{}, nil)
+	repositoryMock.On("List", ctx, mock.Anything).Return(&model.RepoPage{Repos: []*model.PrivateRepoModel{{}}}, nil)
 
 	_, err := service.FindByFindByIdentifier(ctx, name)
 
@@ -182,7 +304,7 @@ func TestFindByFindByIdentifier_Error(t *testing.T) {
 	service := service.NewRepoService(repositoryMock)
 	identifier := "Test"
 
-	repositoryMock.On("FindByName", ctx, mock.Anything).Return(&model.PrivateRepoModel{}, assert.AnError)
+	repositoryMock.On("List", ctx, mock.Anything).Return((*model.RepoPage)(nil), assert.AnError)
 
 	_, err := service.FindByFindByIdentifier(ctx, identifier)
 
@@ -192,12 +314,14 @@ func TestFindByFindByIdentifier_Error(t *testing.T) {
 }
 
 func TestUpdate_Success(t *testing.T) {
-	ctx := context.TODO()
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "owner"})
 	repositoryMock := new(RepositoryMock)
 
 	service := service.NewRepoService(repositoryMock)
-	repoToBeUpdated := &model.PrivateRepoModel{}
+	repoToBeUpdated := &model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: "test"}
 
+	repositoryMock.On("FindById", mock.Anything, repoToBeUpdated.ID.Hex()).Return(&model.PrivateRepoModel{ID: repoToBeUpdated.ID, OwnerID: "owner"}, nil)
+	repositoryMock.On("FindByOwnerAndSkeleton", mock.Anything, mock.Anything, mock.Anything).Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
 	repositoryMock.On("UpdateOne", ctx, mock.Anything).Return(&model.PrivateRepoModel{}, nil)
 
 	_, err := service.Update(ctx, repoToBeUpdated)
@@ -208,12 +332,14 @@ func TestUpdate_Success(t *testing.T) {
 }
 
 func TestUpdate_Error(t *testing.T) {
-	ctx := context.TODO()
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "owner"})
 	repositoryMock := new(RepositoryMock)
 
 	service := service.NewRepoService(repositoryMock)
-	repoToBeUpdated := &model.PrivateRepoModel{}
+	repoToBeUpdated := &model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: "test"}
 
+	repositoryMock.On("FindById", mock.Anything, repoToBeUpdated.ID.Hex()).Return(&model.PrivateRepoModel{ID: repoToBeUpdated.ID, OwnerID: "owner"}, nil)
+	repositoryMock.On("FindByOwnerAndSkeleton", mock.Anything, mock.Anything, mock.Anything).Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
 	repositoryMock.On("UpdateOne", ctx, mock.Anything).Return(&model.PrivateRepoModel{}, assert.AnError)
 
 	_, err := service.Update(ctx, repoToBeUpdated)
@@ -224,13 +350,17 @@ func TestUpdate_Error(t *testing.T) {
 }
 
 func TestDelete_Success(t *testing.T) {
-	ctx := context.TODO()
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "owner"})
 	repositoryMock := new(RepositoryMock)
 
 	service := service.NewRepoService(repositoryMock)
-	repoToBeDeleted := &model.PrivateRepoModel{}
+	repoToBeDeleted := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner"}
 
-	repositoryMock.On("DeleteOne", ctx, mock.Anything).Return(nil)
+	deletedAt := time.Now()
+	trashed := &model.PrivateRepoModel{ID: repoToBeDeleted.ID, OwnerID: "owner", DeletedAt: &deletedAt}
+
+	repositoryMock.On("FindById", mock.Anything, repoToBeDeleted.ID.Hex()).Return(repoToBeDeleted, nil)
+	repositoryMock.On("Trash", ctx, repoToBeDeleted.ID.Hex(), mock.Anything).Return(trashed, nil)
 
 	err := service.Delete(ctx, repoToBeDeleted)
 
@@ -240,13 +370,14 @@ func TestDelete_Success(t *testing.T) {
 }
 
 func TestDelete_Error(t *testing.T) {
-	ctx := context.TODO()
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "owner"})
 	repositoryMock := new(RepositoryMock)
 
 	service := service.NewRepoService(repositoryMock)
-	repoToBeDeleted := &model.PrivateRepoModel{}
+	repoToBeDeleted := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner"}
 
-	repositoryMock.On("DeleteOne", ctx, mock.Anything).Return(assert.AnError)
+	repositoryMock.On("FindById", mock.Anything, repoToBeDeleted.ID.Hex()).Return(repoToBeDeleted, nil)
+	repositoryMock.On("Trash", ctx, repoToBeDeleted.ID.Hex(), mock.Anything).Return((*model.PrivateRepoModel)(nil), assert.AnError)
 
 	err := service.Delete(ctx, repoToBeDeleted)
 
@@ -254,3 +385,459 @@ func TestDelete_Error(t *testing.T) {
 
 	repositoryMock.AssertExpectations(t)
 }
+
+func TestList_Defaults(t *testing.T) {
+	ctx := context.TODO()
+	repositoryMock := new(RepositoryMock)
+
+	service := service.NewRepoService(repositoryMock)
+
+	repositoryMock.On("List", ctx, &model.RepoListQuery{
+		SortBy: model.SortByCreatedAt,
+		Limit:  30,
+		Access: &model.RepoAccess{Visibilities: []string{model.VisibilityPublic}},
+	}).Return(&model.RepoPage{}, nil)
+
+	_, err := service.List(ctx, &model.RepoListQuery{})
+
+	assert.Nil(t, err)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestList_Error_Validation(t *testing.T) {
+	ctx := context.TODO()
+	repositoryMock := new(RepositoryMock)
+
+	service := service.NewRepoService(repositoryMock)
+
+	_, err := service.List(ctx, &model.RepoListQuery{SortBy: "stars", Limit: 1000})
+
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+	assert.Len(t, repoerr.ViolationsOf(err), 2)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestCreate_Error_SlashInName(t *testing.T) {
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "owner"})
+	repositoryMock := new(RepositoryMock)
+
+	service := service.NewRepoService(repositoryMock)
+	repoToBeCreated := &public_repo.CreateRepoModel{
+		Name: "owner/test",
+	}
+
+	_, err := service.Create(ctx, repoToBeCreated)
+
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestFindByFullName_Success(t *testing.T) {
+	ctx := context.TODO()
+	repositoryMock := new(RepositoryMock)
+
+	service := service.NewRepoService(repositoryMock)
+	ownerID := primitive.NewObjectID().Hex()
+
+	repositoryMock.On("FindByOwnerAndName", ctx, ownerID, "test").Return(&model.PrivateRepoModel{}, nil)
+
+	_, err := service.FindByFullName(ctx, ownerID+"/test")
+
+	assert.Nil(t, err)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestFindByFullName_Error_Malformed(t *testing.T) {
+	ctx := context.TODO()
+	repositoryMock := new(RepositoryMock)
+
+	service := service.NewRepoService(repositoryMock)
+
+	for _, fullName := range []string{"test", "/test", "owner/", "owner/test/extra"} {
+		_, err := service.FindByFullName(ctx, fullName)
+
+		assert.ErrorIs(t, err, repoerr.ErrNotFound, fullName)
+	}
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestFindByFindByIdentifier_FullName(t *testing.T) {
+	ctx := context.TODO()
+	repositoryMock := new(RepositoryMock)
+
+	service := service.NewRepoService(repositoryMock)
+
+	repositoryMock.On("FindByOwnerAndName", ctx, "owner", "test").Return(&model.PrivateRepoModel{}, nil)
+
+	_, err := service.FindByFindByIdentifier(ctx, "owner/test")
+
+	assert.Nil(t, err)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestPatch_Success(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	objectID := primitive.NewObjectID()
+	id := objectID.Hex()
+
+	name := "Renamed Repo"
+	repositoryMock.On("FindById", mock.Anything, id).Return(&model.PrivateRepoModel{ID: objectID, OwnerID: "owner"}, nil)
+	repositoryMock.On("FindByOwnerAndSkeleton", mock.Anything, "owner", "renamed-repo").Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
+	repositoryMock.On("PatchOne", mock.Anything, id, mock.MatchedBy(func(patch *model.RepoPatch) bool {
+		return *patch.Name == "renamed-repo" && patch.Skeleton == "renamed-repo" && patch.Description == nil && !patch.UpdatedAt.IsZero()
+	})).Return(&model.PrivateRepoModel{}, nil)
+
+	_, err := service.NewRepoService(repositoryMock).Patch(identity.NewContext(context.TODO(), identity.Caller{ID: "owner"}), id, &model.RepoPatch{Name: &name})
+
+	assert.Nil(t, err)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestPatch_Error_EmptyName(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+
+	name := " "
+	_, err := service.NewRepoService(repositoryMock).Patch(context.TODO(), primitive.NewObjectID().Hex(), &model.RepoPatch{Name: &name})
+
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestCreate_Error_PolicyViolation(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+
+	_, err := service.NewRepoService(repositoryMock).Create(identity.NewContext(context.TODO(), identity.Caller{ID: "owner"}), &public_repo.CreateRepoModel{Name: "repo.git"})
+
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestValidateName_Available(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+
+	repositoryMock.On("FindByOwnerAndSkeleton", mock.Anything, mock.Anything, mock.Anything).Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
+	repositoryMock.On("FindByOwnerAndName", mock.Anything, "owner", "my-repo").Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
+
+	name, err := service.NewRepoService(repositoryMock).ValidateName(identity.NewContext(context.TODO(), identity.Caller{ID: "owner"}), "My Repo")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "my-repo", name)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestValidateName_Anonymous(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+
+	name, err := service.NewRepoService(repositoryMock).ValidateName(context.TODO(), "My Repo")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "my-repo", name)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestCreate_Error_Anonymous(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+
+	_, err := service.NewRepoService(repositoryMock).Create(context.TODO(), &public_repo.CreateRepoModel{Name: "test"})
+
+	assert.ErrorIs(t, err, repoerr.ErrUnauthenticated)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestValidateName_Taken(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+
+	repositoryMock.On("FindByOwnerAndName", mock.Anything, "owner", "my-repo").Return(&model.PrivateRepoModel{}, nil)
+
+	_, err := service.NewRepoService(repositoryMock).ValidateName(identity.NewContext(context.TODO(), identity.Caller{ID: "owner"}), "my-repo")
+
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestCreate_NormalizesUnicode(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+
+	repositoryMock.On("FindByOwnerAndSkeleton", mock.Anything, "owner", "cafe").Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
+	repositoryMock.On("Create", mock.Anything, mock.MatchedBy(func(repo *model.PrivateRepoModel) bool {
+		return repo.Name == "cafe" && repo.Skeleton == "cafe"
+	})).Return(&model.PrivateRepoModel{}, nil)
+
+	_, err := service.NewRepoService(repositoryMock).Create(identity.NewContext(context.TODO(), identity.Caller{ID: "owner"}), &public_repo.CreateRepoModel{Name: "Cafe\u0301"})
+
+	assert.Nil(t, err)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestCreate_Error_Confusable(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+
+	existing := &model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: "paypal", OwnerID: "owner"}
+	repositoryMock.On("FindByOwnerAndSkeleton", mock.Anything, "owner", "paypal").Return(existing, nil)
+
+	_, err := service.NewRepoService(repositoryMock).Create(identity.NewContext(context.TODO(), identity.Caller{ID: "owner"}), &public_repo.CreateRepoModel{Name: "paypa1"})
+
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+	assert.Equal(t, []repoerr.Violation{{Field: "name", Message: `is too similar to existing repo "paypal"`}}, repoerr.ViolationsOf(err))
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestUpdate_KeepsOwnSkeleton(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: "paypal", OwnerID: "owner"}
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+	repositoryMock.On("FindByOwnerAndSkeleton", mock.Anything, "owner", "paypal").Return(repo, nil)
+	repositoryMock.On("UpdateOne", mock.Anything, repo).Return(repo, nil)
+
+	_, err := service.NewRepoService(repositoryMock).Update(identity.NewContext(context.TODO(), identity.Caller{ID: "owner"}), repo)
+
+	assert.Nil(t, err)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestFindById_Private_HiddenFromOthers(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner", Visibility: model.VisibilityPrivate}
+
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	service := service.NewRepoService(repositoryMock)
+
+	_, err := service.FindById(context.TODO(), repo.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = service.FindById(identity.NewContext(context.TODO(), identity.Caller{ID: "someone"}), repo.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	found, err := service.FindById(identity.NewContext(context.TODO(), identity.Caller{ID: "owner"}), repo.ID.Hex())
+	assert.Nil(t, err)
+	assert.Equal(t, repo, found)
+}
+
+func TestPrivate_VisibleToAdmins(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	created, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "secret", Visibility: model.VisibilityPrivate})
+	require.NoError(t, err)
+
+	admin := identity.NewContext(context.TODO(), identity.Caller{ID: "admin", Roles: []string{"admin"}})
+
+	found, err := repoService.FindById(admin, created.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, found.ID)
+
+	_, err = repoService.FindByFullName(admin, "owner/secret")
+	assert.Nil(t, err)
+
+	page, err := repoService.List(admin, &model.RepoListQuery{})
+	require.NoError(t, err)
+	assert.Len(t, page.Repos, 1)
+
+	description := "audited"
+	patched, err := repoService.Patch(admin, created.ID.Hex(), &model.RepoPatch{Description: &description})
+	require.NoError(t, err)
+	assert.Equal(t, "audited", patched.Description)
+}
+
+func TestFindByName_Internal_HiddenFromAnonymous(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	_, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "test", Visibility: model.VisibilityInternal})
+	require.NoError(t, err)
+
+	_, err = repoService.FindByName(context.TODO(), "test")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = repoService.FindByName(as("someone"), "test")
+	assert.Nil(t, err)
+}
+
+func TestList_RestrictsToVisible(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	service := service.NewRepoService(repositoryMock)
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "caller"})
+
+	shared := primitive.NewObjectID()
+	_, err := service.Collaborators.Add(ctx, &model.Collaborator{RepoID: shared, UserID: "caller", Role: model.CollaboratorRead})
+	assert.Nil(t, err)
+
+	repositoryMock.On("List", mock.Anything, mock.MatchedBy(func(query *model.RepoListQuery) bool {
+		return assert.ObjectsAreEqual(&model.RepoAccess{
+			Visibilities: []string{model.VisibilityPublic, model.VisibilityInternal},
+			MemberID:     "caller",
+			RepoIDs:      []primitive.ObjectID{shared},
+		}, query.Access)
+	})).Return(&model.RepoPage{}, nil)
+
+	_, err = service.List(ctx, &model.RepoListQuery{})
+
+	assert.Nil(t, err)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestList_NormalizesNamePrefix(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	created, err := repoService.Create(as("alice"), &public_repo.CreateRepoModel{Name: "Café Tools"})
+	require.NoError(t, err)
+
+	page, err := repoService.List(as("alice"), &model.RepoListQuery{NamePrefix: "CAFÉ T"})
+	require.NoError(t, err)
+	require.Len(t, page.Repos, 1)
+	assert.Equal(t, created.ID, page.Repos[0].ID)
+}
+
+func TestSetVisibility_Success(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner", Version: 4}
+
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+	repositoryMock.On("PatchOne", mock.Anything, repo.ID.Hex(), mock.MatchedBy(func(patch *model.RepoPatch) bool {
+		return *patch.Visibility == model.VisibilityPrivate && patch.Version == 4 && patch.Name == nil
+	})).Return(&model.PrivateRepoModel{ID: repo.ID, OwnerID: "owner", Visibility: model.VisibilityPrivate, Version: 5}, nil)
+
+	service := service.NewRepoService(repositoryMock)
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "owner"})
+
+	updated, err := service.SetVisibility(ctx, repo.ID.Hex(), model.VisibilityPrivate)
+
+	assert.Nil(t, err)
+	assert.Equal(t, model.VisibilityPrivate, updated.Visibility)
+
+	entries, err := service.Audit.ListByRepo(ctx, repo.ID.Hex())
+	assert.Nil(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, model.AuditVisibilityChanged, entries[0].Action)
+	assert.Equal(t, "owner", entries[0].ActorID)
+	assert.Equal(t, map[string]string{"from": "public", "to": "private"}, entries[0].Details)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestSetVisibility_Error_NotOwner(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner"}
+
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "someone"})
+	_, err := service.NewRepoService(repositoryMock).SetVisibility(ctx, repo.ID.Hex(), model.VisibilityPrivate)
+
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestSetVisibility_Unchanged(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner", Visibility: model.VisibilityInternal}
+
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	service := service.NewRepoService(repositoryMock)
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "owner"})
+
+	_, err := service.SetVisibility(ctx, repo.ID.Hex(), model.VisibilityInternal)
+	assert.Nil(t, err)
+
+	entries, _ := service.Audit.ListByRepo(ctx, repo.ID.Hex())
+	assert.Empty(t, entries)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestUpdate_Error_NotOwner(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: "test", OwnerID: "owner"}
+
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(&model.PrivateRepoModel{ID: repo.ID, Name: "test", OwnerID: "owner"}, nil)
+
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "someone"})
+	_, err := service.NewRepoService(repositoryMock).Update(ctx, repo)
+
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestUpdate_KeepsStoredOwner(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), Name: "test", OwnerID: "someone"}
+
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(&model.PrivateRepoModel{ID: repo.ID, Name: "test", OwnerID: "owner"}, nil)
+	repositoryMock.On("FindByOwnerAndSkeleton", mock.Anything, "owner", "test").Return((*model.PrivateRepoModel)(nil), repoerr.NotFound("repo not found"))
+	repositoryMock.On("UpdateOne", mock.Anything, mock.MatchedBy(func(repo *model.PrivateRepoModel) bool {
+		return repo.OwnerID == "owner"
+	})).Return(repo, nil)
+
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "admin", Roles: []string{"admin"}})
+	_, err := service.NewRepoService(repositoryMock).Update(ctx, repo)
+
+	assert.Nil(t, err)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestPatch_Error_Anonymous(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	id := primitive.NewObjectID().Hex()
+
+	description := "patched"
+	repositoryMock.On("FindById", mock.Anything, id).Return(&model.PrivateRepoModel{OwnerID: "owner"}, nil)
+
+	_, err := service.NewRepoService(repositoryMock).Patch(context.TODO(), id, &model.RepoPatch{Description: &description})
+
+	assert.ErrorIs(t, err, repoerr.ErrUnauthenticated)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestDelete_Error_NotOwner(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner"}
+
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	ctx := identity.NewContext(context.TODO(), identity.Caller{ID: "someone"})
+	err := service.NewRepoService(repositoryMock).Delete(ctx, repo)
+
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+
+	repositoryMock.AssertExpectations(t)
+}
+
+func TestDelete_CustomPolicy(t *testing.T) {
+	repositoryMock := new(RepositoryMock)
+	repo := &model.PrivateRepoModel{ID: primitive.NewObjectID(), OwnerID: "owner"}
+
+	repositoryMock.On("FindById", mock.Anything, repo.ID.Hex()).Return(repo, nil)
+
+	service := service.NewRepoService(repositoryMock)
+	service.Authorizer = authz.PolicyFunc(func(ctx context.Context, caller identity.Caller, action authz.Action, repo *model.PrivateRepoModel) error {
+		if action == authz.ActionDelete {
+			return repoerr.PermissionDenied("repos are never deleted")
+		}
+		return nil
+	})
+
+	err := service.Delete(identity.NewContext(context.TODO(), identity.Caller{ID: "owner"}), repo)
+
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+
+	repositoryMock.AssertExpectations(t)
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SubscriptionService lets users star and watch repos and lists who did
+type SubscriptionService interface {
+	Star(ctx context.Context, id string) error
+	Unstar(ctx context.Context, id string) error
+	Watch(ctx context.Context, id string) error
+	Unwatch(ctx context.Context, id string) error
+	ListStargazers(ctx context.Context, id string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error)
+	ListWatchers(ctx context.Context, id string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error)
+	ListStarred(ctx context.Context, userID string, query *model.SubscriptionQuery) (*model.RepoPage, error)
+}
+
+// Star stars a repo the caller can see for them, starring it again changes nothing
+func (s *RepoServiceImpl) Star(ctx context.Context, id string) error {
+	return s.subscribe(ctx, id, model.SubscriptionStar)
+}
+
+// Unstar takes the caller's star back. It does not matter whether the caller can
+// still see the repo or ever starred it.
+func (s *RepoServiceImpl) Unstar(ctx context.Context, id string) error {
+	return s.unsubscribe(ctx, id, model.SubscriptionStar)
+}
+
+// Watch subscribes the caller to a repo they can see, like Star
+func (s *RepoServiceImpl) Watch(ctx context.Context, id string) error {
+	return s.subscribe(ctx, id, model.SubscriptionWatch)
+}
+
+// Unwatch ends the caller's subscription to a repo, like Unstar
+func (s *RepoServiceImpl) Unwatch(ctx context.Context, id string) error {
+	return s.unsubscribe(ctx, id, model.SubscriptionWatch)
+}
+
+// ListStargazers pages through the users who starred a repo the caller can see,
+// newest first
+func (s *RepoServiceImpl) ListStargazers(ctx context.Context, id string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error) {
+	return s.listSubscribers(ctx, id, model.SubscriptionStar, query)
+}
+
+// ListWatchers pages through the users watching a repo the caller can see, newest first
+func (s *RepoServiceImpl) ListWatchers(ctx context.Context, id string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error) {
+	return s.listSubscribers(ctx, id, model.SubscriptionWatch, query)
+}
+
+// ListStarred pages through the repos a user starred that the caller can see, most
+// recently starred first. Pages are cut from the user's stars, so a page comes out
+// short by the starred repos the caller cannot see.
+func (s *RepoServiceImpl) ListStarred(ctx context.Context, userID string, query *model.SubscriptionQuery) (*model.RepoPage, error) {
+	if violation := checkLimit(&query.Limit); violation != nil {
+		return nil, repoerr.Validation(*violation)
+	}
+
+	access, err := s.accessFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	stars, err := s.Subscriptions.ListByUser(ctx, userID, model.SubscriptionStar, query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &model.RepoPage{Repos: []*model.PrivateRepoModel{}, NextCursor: stars.NextCursor}
+	if len(stars.Subscriptions) == 0 {
+		return page, nil
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(stars.Subscriptions))
+	for _, star := range stars.Subscriptions {
+		ids = append(ids, star.RepoID)
+	}
+	found, err := s.Repository.List(ctx, &model.RepoListQuery{IDs: ids, Access: access, Limit: len(ids)})
+	if err != nil {
+		return nil, err
+	}
+
+	repos := make(map[primitive.ObjectID]*model.PrivateRepoModel, len(found.Repos))
+	for _, repo := range found.Repos {
+		repos[repo.ID] = repo
+	}
+	for _, star := range stars.Subscriptions {
+		if repo, ok := repos[star.RepoID]; ok {
+			page.Repos = append(page.Repos, repo)
+		}
+	}
+
+	return page, nil
+}
+
+// subscribe adds a subscription of the caller and counts it on the repo, unless the
+// caller already held it. A subscription that cannot be counted is removed again.
+func (s *RepoServiceImpl) subscribe(ctx context.Context, id string, kind string) error {
+	caller, _ := identity.FromContext(ctx)
+	if caller.ID == "" {
+		return repoerr.Unauthenticated("sign in to " + kind + " a repo")
+	}
+
+	repo, err := s.FindById(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	added, err := s.Subscriptions.Add(ctx, &model.Subscription{
+		RepoID:    repo.ID,
+		UserID:    caller.ID,
+		Kind:      kind,
+		CreatedAt: time.Now(),
+	})
+	if err != nil || !added {
+		return err
+	}
+
+	if err := s.countSubscription(ctx, repo.ID, kind, 1); err != nil {
+		if _, removeErr := s.Subscriptions.Remove(ctx, repo.ID, caller.ID, kind); removeErr != nil {
+			return errors.Join(err, fmt.Errorf("remove uncounted %s: %w", kind, removeErr))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// unsubscribe removes a subscription of the caller and takes it off the repo's count.
+// A removal that cannot be counted is undone, under a new timestamp though.
+func (s *RepoServiceImpl) unsubscribe(ctx context.Context, id string, kind string) error {
+	caller, _ := identity.FromContext(ctx)
+	if caller.ID == "" {
+		return repoerr.Unauthenticated("sign in to un" + kind + " a repo")
+	}
+
+	repoID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid repo id %q", id)
+	}
+
+	removed, err := s.Subscriptions.Remove(ctx, repoID, caller.ID, kind)
+	if err != nil || !removed {
+		return err
+	}
+
+	if err := s.countSubscription(ctx, repoID, kind, -1); err != nil {
+		_, addErr := s.Subscriptions.Add(ctx, &model.Subscription{RepoID: repoID, UserID: caller.ID, Kind: kind, CreatedAt: time.Now()})
+		if addErr != nil {
+			return errors.Join(err, fmt.Errorf("restore uncounted %s: %w", kind, addErr))
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (s *RepoServiceImpl) listSubscribers(ctx context.Context, id string, kind string, query *model.SubscriptionQuery) (*model.SubscriptionPage, error) {
+	if violation := checkLimit(&query.Limit); violation != nil {
+		return nil, repoerr.Validation(*violation)
+	}
+
+	repo, err := s.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Subscriptions.ListByRepo(ctx, repo.ID, kind, query)
+}
+
+// countSubscription changes the star or watcher count of a repo by delta. A repo that
+// was purged meanwhile has nothing left to count.
+func (s *RepoServiceImpl) countSubscription(ctx context.Context, repoID primitive.ObjectID, kind string, delta int) error {
+	add := s.Repository.AddStars
+	if kind == model.SubscriptionWatch {
+		add = s.Repository.AddWatchers
+	}
+
+	err := add(ctx, repoID.Hex(), delta)
+	if err != nil && !errors.Is(err, repoerr.ErrNotFound) {
+		return fmt.Errorf("count %s: %w", kind, err)
+	}
+
+	return nil
+}
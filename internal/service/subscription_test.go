@@ -0,0 +1,212 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStar(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+
+	require.NoError(t, repoService.Star(as("someone"), repo.ID.Hex()))
+	require.NoError(t, repoService.Star(as("someone"), repo.ID.Hex()))
+	require.NoError(t, repoService.Star(as("other"), repo.ID.Hex()))
+	require.NoError(t, repoService.Watch(as("someone"), repo.ID.Hex()))
+
+	found, err := repoService.FindById(as("owner"), repo.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), found.StarsCount)
+	assert.Equal(t, int64(1), found.WatchersCount)
+
+	require.NoError(t, repoService.Unstar(as("someone"), repo.ID.Hex()))
+	require.NoError(t, repoService.Unstar(as("someone"), repo.ID.Hex()))
+	require.NoError(t, repoService.Unwatch(as("nobody"), repo.ID.Hex()))
+
+	found, err = repoService.FindById(as("owner"), repo.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), found.StarsCount)
+	assert.Equal(t, int64(1), found.WatchersCount)
+	assert.Equal(t, repo.Version, found.Version)
+}
+
+func TestStar_Error(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools", Visibility: model.VisibilityPrivate})
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, repoService.Star(context.TODO(), repo.ID.Hex()), repoerr.ErrUnauthenticated)
+	assert.ErrorIs(t, repoService.Unwatch(context.TODO(), repo.ID.Hex()), repoerr.ErrUnauthenticated)
+	assert.ErrorIs(t, repoService.Watch(as("someone"), repo.ID.Hex()), repoerr.ErrNotFound)
+	assert.ErrorIs(t, repoService.Unstar(as("someone"), "invalid"), repoerr.ErrInvalidID)
+}
+
+// Stars stay with a repo while its visibility changes and can be taken back after
+// the repo went out of sight
+func TestUnstar_HiddenRepo(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	require.NoError(t, repoService.Star(as("someone"), repo.ID.Hex()))
+
+	_, err = repoService.SetVisibility(as("owner"), repo.ID.Hex(), model.VisibilityPrivate)
+	require.NoError(t, err)
+
+	starred, err := repoService.ListStarred(as("someone"), "someone", &model.SubscriptionQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, starred.Repos)
+
+	require.NoError(t, repoService.Unstar(as("someone"), repo.ID.Hex()))
+
+	found, err := repoService.FindById(as("owner"), repo.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), found.StarsCount)
+}
+
+func TestListStargazersAndWatchers(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools", Visibility: model.VisibilityPrivate})
+	require.NoError(t, err)
+
+	for _, userID := range []string{"owner", "a", "b"} {
+		if userID != "owner" {
+			_, err := repoService.AddCollaborator(as("owner"), repo.ID.Hex(), userID, model.CollaboratorRead)
+			require.NoError(t, err)
+		}
+		require.NoError(t, repoService.Star(as(userID), repo.ID.Hex()))
+	}
+	require.NoError(t, repoService.Watch(as("a"), repo.ID.Hex()))
+
+	page, err := repoService.ListStargazers(as("a"), repo.ID.Hex(), &model.SubscriptionQuery{Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Subscriptions, 2)
+	assert.Equal(t, "b", page.Subscriptions[0].UserID)
+	assert.Equal(t, "a", page.Subscriptions[1].UserID)
+
+	page, err = repoService.ListStargazers(as("a"), repo.ID.Hex(), &model.SubscriptionQuery{Limit: 2, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, page.Subscriptions, 1)
+	assert.Equal(t, "owner", page.Subscriptions[0].UserID)
+	assert.Empty(t, page.NextCursor)
+
+	page, err = repoService.ListWatchers(as("owner"), repo.ID.Hex(), &model.SubscriptionQuery{})
+	require.NoError(t, err)
+	require.Len(t, page.Subscriptions, 1)
+	assert.Equal(t, model.SubscriptionWatch, page.Subscriptions[0].Kind)
+
+	_, err = repoService.ListStargazers(as("someone"), repo.ID.Hex(), &model.SubscriptionQuery{})
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	_, err = repoService.ListWatchers(as("owner"), repo.ID.Hex(), &model.SubscriptionQuery{Limit: service.MaxListLimit + 1})
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+}
+
+func TestListStarred(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	popular, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "popular"})
+	require.NoError(t, err)
+	niche, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "niche"})
+	require.NoError(t, err)
+	_, err = repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "unstarred"})
+	require.NoError(t, err)
+
+	require.NoError(t, repoService.Star(as("user"), niche.ID.Hex()))
+	require.NoError(t, repoService.Star(as("user"), popular.ID.Hex()))
+	require.NoError(t, repoService.Star(as("other"), popular.ID.Hex()))
+
+	page, err := repoService.ListStarred(context.TODO(), "user", &model.SubscriptionQuery{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, page.Repos, 1)
+	assert.Equal(t, "popular", page.Repos[0].Name)
+	assert.Equal(t, int64(2), page.Repos[0].StarsCount)
+
+	page, err = repoService.ListStarred(context.TODO(), "user", &model.SubscriptionQuery{Limit: 1, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	require.Len(t, page.Repos, 1)
+	assert.Equal(t, "niche", page.Repos[0].Name)
+	assert.Empty(t, page.NextCursor)
+
+	page, err = repoService.ListStarred(context.TODO(), "nobody", &model.SubscriptionQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, page.Repos)
+
+	_, err = repoService.ListStarred(context.TODO(), "user", &model.SubscriptionQuery{Limit: service.MaxListLimit + 1})
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+}
+
+func TestPurgeTrash_RemovesSubscriptions(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	require.NoError(t, repoService.Star(as("user"), repo.ID.Hex()))
+	require.NoError(t, repoService.Delete(as("owner"), repo))
+
+	purged, err := repoService.PurgeTrash(context.TODO(), time.Now().Add(service.DefaultTrashRetention+time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+
+	starred, err := repoService.Subscriptions.ListByUser(context.TODO(), "user", model.SubscriptionStar, &model.SubscriptionQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, starred.Subscriptions)
+}
+
+// uncountedStars loses every change to a star count
+type uncountedStars struct {
+	*repository.MemoryRepoRepository
+}
+
+func (uncountedStars) AddStars(ctx context.Context, id string, delta int) error {
+	return assert.AnError
+}
+
+func TestStar_Error_CountFails(t *testing.T) {
+	repoService := service.NewRepoService(uncountedStars{repository.NewMemoryRepoRepository()})
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, repoService.Star(as("someone"), repo.ID.Hex()), assert.AnError)
+
+	stargazers, err := repoService.ListStargazers(as("owner"), repo.ID.Hex(), &model.SubscriptionQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, stargazers.Subscriptions)
+}
+
+func TestReconcileCounts_Subscriptions(t *testing.T) {
+	repos := repository.NewMemoryRepoRepository()
+	repoService := service.NewRepoService(repos)
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	require.NoError(t, repoService.Star(as("someone"), repo.ID.Hex()))
+	require.NoError(t, repoService.Watch(as("someone"), repo.ID.Hex()))
+	require.NoError(t, repoService.Watch(as("other"), repo.ID.Hex()))
+	require.NoError(t, repos.AddStars(context.TODO(), repo.ID.Hex(), 5))
+	require.NoError(t, repos.AddWatchers(context.TODO(), repo.ID.Hex(), -1))
+
+	reconciled, err := repoService.ReconcileCounts(asAdmin("admin"), repo.ID.Hex())
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), reconciled.StarsCount)
+	assert.Equal(t, int64(2), reconciled.WatchersCount)
+}
+
+func TestReconcileCounts_Error_NotAdmin(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+
+	_, err = repoService.ReconcileCounts(context.TODO(), repo.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrUnauthenticated)
+
+	_, err = repoService.ReconcileCounts(as("owner"), repo.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+}
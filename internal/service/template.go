@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/authz"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// fromTemplate creates a repo as a copy of the template repo the model names. The
+// template's description, topics and visibility fill in what the model leaves out,
+// its custom properties are merged with the model's, which take precedence.
+// {{name}} and {{owner}} in the template's description are replaced with the name
+// and owner of the new repo. Collaborators of the template only carry over if the
+// authorization policy lets the caller see them. A repo whose collaborators cannot
+// all be copied is removed again.
+func (s *RepoServiceImpl) fromTemplate(ctx context.Context, create *public_repo.CreateRepoModel) (*model.PrivateRepoModel, error) {
+	caller, _ := identity.FromContext(ctx)
+	if caller.ID == "" {
+		return nil, repoerr.Unauthenticated("sign in to create a repo")
+	}
+
+	template, err := s.findTemplate(ctx, create.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := *create
+	if merged.Visibility == "" {
+		merged.Visibility = template.EffectiveVisibility()
+	}
+	if len(merged.Topics) == 0 {
+		merged.Topics = template.Topics
+	}
+	if len(template.Properties) > 0 {
+		merged.Properties = make(map[string]string, len(template.Properties)+len(create.Properties))
+		for name, value := range template.Properties {
+			merged.Properties[name] = value
+		}
+		for name, value := range create.Properties {
+			merged.Properties[name] = value
+		}
+	}
+
+	repo, err := s.newRepo(ctx, &merged)
+	if err != nil {
+		return nil, err
+	}
+	if create.Description == "" {
+		owner, err := s.ownerLogin(ctx, repo)
+		if err != nil {
+			return nil, err
+		}
+		repo.Description = strings.NewReplacer("{{name}}", repo.Name, "{{owner}}", owner).Replace(template.Description)
+	}
+	repo.TemplateID = &template.ID
+
+	created, err := s.insert(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.copyCollaborators(ctx, caller, template, created); err != nil {
+		removeErr := s.Collaborators.RemoveByRepo(ctx, created.ID)
+		if removeErr == nil {
+			removeErr = s.Repository.DeleteOne(ctx, created)
+		}
+		if removeErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("remove partly copied repo: %w", removeErr))
+		}
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// findTemplate looks up a template repo the caller can see by id or full name
+func (s *RepoServiceImpl) findTemplate(ctx context.Context, identifier string) (*model.PrivateRepoModel, error) {
+	template, err := s.FindByFindByIdentifier(ctx, identifier)
+	switch {
+	case errors.Is(err, repoerr.ErrNotFound), errors.Is(err, repoerr.ErrInvalidID):
+		return nil, repoerr.Validation(repoerr.Violation{Field: "template", Message: "does not name a repo"})
+	case err != nil:
+		return nil, err
+	case !template.IsTemplate:
+		return nil, repoerr.Validation(repoerr.Violation{Field: "template", Message: "is not a template repo"})
+	}
+
+	return template, nil
+}
+
+// ownerLogin is how the owner of a repo is addressed in its full name
+func (s *RepoServiceImpl) ownerLogin(ctx context.Context, repo *model.PrivateRepoModel) (string, error) {
+	if repo.EffectiveOwnerType() != model.OwnerOrganization {
+		return repo.OwnerID, nil
+	}
+
+	orgID, err := primitive.ObjectIDFromHex(repo.OwnerID)
+	if err != nil {
+		return "", repoerr.Wrap(repoerr.ErrInvalidID, err, "invalid organization id %q", repo.OwnerID)
+	}
+	org, err := s.Organizations.FindByID(ctx, orgID)
+	if err != nil {
+		return "", err
+	}
+
+	return org.Login, nil
+}
+
+// copyCollaborators gives the collaborators of a template the same roles on a repo
+// created from it, as long as the caller may list them on the template. The owner of
+// the new repo is skipped.
+func (s *RepoServiceImpl) copyCollaborators(ctx context.Context, caller identity.Caller, template *model.PrivateRepoModel, repo *model.PrivateRepoModel) error {
+	err := s.Authorizer.Authorize(ctx, caller, authz.ActionListCollaborators, template)
+	if errors.Is(err, repoerr.ErrPermissionDenied) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	collaborators, err := s.Collaborators.ListByRepo(ctx, template.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, collaborator := range collaborators {
+		if collaborator.UserID == repo.OwnerID {
+			continue
+		}
+
+		_, err := s.Collaborators.Add(ctx, &model.Collaborator{
+			RepoID:    repo.ID,
+			UserID:    collaborator.UserID,
+			Role:      collaborator.Role,
+			CreatedAt: repo.CreatedAt,
+			UpdatedAt: repo.CreatedAt,
+		})
+		if err != nil {
+			return err
+		}
+
+		details := map[string]string{"user": collaborator.UserID, "role": collaborator.Role, "template": template.ID.Hex()}
+		if err := s.recordCollaboratorChange(ctx, repo, caller.ID, model.AuditCollaboratorAdded, details); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTemplate creates "owner/service-template", an internal template repo with
+// "friend" as a collaborator
+func newTemplate(t *testing.T, repoService *service.RepoServiceImpl) *model.PrivateRepoModel {
+	template, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{
+		Name:        "service-template",
+		Description: "The {{name}} service of {{owner}}",
+		Visibility:  model.VisibilityInternal,
+		Topics:      []string{"go", "microservice"},
+		Properties:  map[string]string{"team": "platform", "tier": "2"},
+	})
+	require.NoError(t, err)
+	_, err = repoService.AddCollaborator(as("owner"), template.ID.Hex(), "friend", model.CollaboratorWrite)
+	require.NoError(t, err)
+
+	isTemplate := true
+	template, err = repoService.Patch(as("owner"), template.ID.Hex(), &model.RepoPatch{IsTemplate: &isTemplate})
+	require.NoError(t, err)
+
+	return template
+}
+
+func TestCreate_FromTemplate(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	template := newTemplate(t, repoService)
+
+	created, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{
+		Name:       "billing",
+		Template:   "owner/service-template",
+		Properties: map[string]string{"tier": "1"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "The billing service of owner", created.Description)
+	assert.Equal(t, model.VisibilityInternal, created.EffectiveVisibility())
+	assert.Equal(t, []string{"go", "microservice"}, created.Topics)
+	assert.Equal(t, map[string]string{"team": "platform", "tier": "1"}, created.Properties)
+	assert.Equal(t, template.ID, *created.TemplateID)
+	assert.False(t, created.IsTemplate)
+
+	collaborators, err := repoService.ListCollaborators(as("owner"), created.ID.Hex())
+	require.NoError(t, err)
+	require.Len(t, collaborators, 1)
+	assert.Equal(t, "friend", collaborators[0].UserID)
+	assert.Equal(t, model.CollaboratorWrite, collaborators[0].Role)
+	entries, _ := repoService.Audit.ListByRepo(context.TODO(), created.ID.Hex())
+	require.Len(t, entries, 1)
+	assert.Equal(t, template.ID.Hex(), entries[0].Details["template"])
+
+	// The template itself is unchanged
+	found, err := repoService.FindById(as("owner"), template.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "platform", "tier": "2"}, found.Properties)
+}
+
+// failingCollaborators fails to add any collaborator
+type failingCollaborators struct {
+	repository.CollaboratorRepository
+}
+
+func (failingCollaborators) Add(ctx context.Context, collaborator *model.Collaborator) (*model.Collaborator, error) {
+	return nil, assert.AnError
+}
+
+func TestCreate_FromTemplate_Error_CopyFails(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	newTemplate(t, repoService)
+	repoService.Collaborators = failingCollaborators{repoService.Collaborators}
+
+	_, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "billing", Template: "owner/service-template"})
+	assert.ErrorIs(t, err, assert.AnError)
+
+	_, err = repoService.FindByFullName(as("owner"), "owner/billing")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func TestCreate_FromTemplate_ExplicitFields(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	template := newTemplate(t, repoService)
+
+	created, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{
+		Name:        "billing",
+		Description: "Invoices",
+		Visibility:  model.VisibilityPrivate,
+		Topics:      []string{"Billing"},
+		Template:    template.ID.Hex(),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Invoices", created.Description)
+	assert.Equal(t, model.VisibilityPrivate, created.EffectiveVisibility())
+	assert.Equal(t, []string{"billing"}, created.Topics)
+}
+
+// Only callers who may list the template's collaborators get them copied
+func TestCreate_FromTemplate_CollaboratorsByPolicy(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	template := newTemplate(t, repoService)
+
+	created, err := repoService.Create(as("someone"), &public_repo.CreateRepoModel{Name: "billing", Template: template.ID.Hex()})
+
+	require.NoError(t, err)
+	assert.Equal(t, "The billing service of someone", created.Description)
+	collaborators, err := repoService.ListCollaborators(as("someone"), created.ID.Hex())
+	require.NoError(t, err)
+	assert.Empty(t, collaborators)
+}
+
+func TestCreate_FromTemplate_Organization(t *testing.T) {
+	repoService := newOrgService(t)
+	template := newTemplate(t, repoService)
+
+	created, err := repoService.Create(as("member"), &public_repo.CreateRepoModel{Name: "billing", Organization: "acme", Template: template.ID.Hex()})
+
+	require.NoError(t, err)
+	assert.Equal(t, "The billing service of acme", created.Description)
+}
+
+func TestCreate_FromTemplate_Error(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	plain, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "plain"})
+	require.NoError(t, err)
+	hidden, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "hidden", Visibility: model.VisibilityPrivate})
+	require.NoError(t, err)
+	isTemplate := true
+	_, err = repoService.Patch(as("owner"), hidden.ID.Hex(), &model.RepoPatch{IsTemplate: &isTemplate})
+	require.NoError(t, err)
+
+	for template, message := range map[string]string{
+		plain.ID.Hex():  "is not a template repo",
+		hidden.ID.Hex(): "does not name a repo",
+		"owner/missing": "does not name a repo",
+	} {
+		_, err = repoService.Create(as("someone"), &public_repo.CreateRepoModel{Name: "copy", Template: template})
+
+		assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+		assert.Equal(t, []repoerr.Violation{{Field: "template", Message: message}}, repoerr.ViolationsOf(err))
+	}
+
+	_, err = repoService.Create(context.TODO(), &public_repo.CreateRepoModel{Name: "copy", Template: "owner/plain"})
+	assert.ErrorIs(t, err, repoerr.ErrUnauthenticated)
+}
+
+func TestPatch_TopicsAndProperties(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+
+	topics := []string{"Go", " go ", "cli-tools"}
+	patched, err := repoService.Patch(as("owner"), repo.ID.Hex(), &model.RepoPatch{Topics: &topics})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go", "cli-tools"}, patched.Topics)
+
+	for _, patch := range []*model.RepoPatch{
+		{Topics: &[]string{"-leading"}},
+		{Topics: &[]string{"no spaces"}},
+		{Properties: &map[string]string{"a b": "c"}},
+	} {
+		_, err = repoService.Patch(as("owner"), repo.ID.Hex(), patch)
+		assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+	}
+
+	_, err = repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "other", Properties: map[string]string{"": "x"}})
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/authz"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/identity"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+)
+
+// Transfer moves a repo to another owner, optionally renaming it on the way. The old
+// "owner/name" keeps resolving to the repo through a redirect, former names of the
+// new owner's repos are only taken over with ReuseName. Team grants always end with
+// the transfer, collaborators only stay if asked to.
+func (s *RepoServiceImpl) Transfer(ctx context.Context, id string, transfer *model.RepoTransfer) (*model.PrivateRepoModel, error) {
+	name := ""
+	if transfer.NewName != "" {
+		var err error
+		if name, err = s.checkName(transfer.NewName); err != nil {
+			return nil, err
+		}
+	}
+
+	repo, err := s.FindById(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	caller, err := s.authorize(ctx, authz.ActionTransfer, repo)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = repo.Name
+	}
+
+	ownerID, ownerType, err := s.transferTarget(ctx, caller, transfer.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+	if ownerID == repo.OwnerID {
+		return nil, repoerr.Validation(repoerr.Violation{Field: "newOwner", Message: "already owns the repo"})
+	}
+
+	_, err = s.Repository.FindByOwnerAndName(ctx, ownerID, name)
+	switch {
+	case err == nil:
+		return nil, repoerr.Conflict("%s already has a repo named %q", transfer.NewOwner, name)
+	case !errors.Is(err, repoerr.ErrNotFound):
+		return nil, err
+	}
+	if err := s.checkFormerName(ctx, ownerID, name, repo.ID, transfer.ReuseName); err != nil {
+		return nil, err
+	}
+	skeleton, err := s.checkSimilar(ctx, ownerID, name, repo.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	patch := &model.RepoPatch{
+		Name:      &name,
+		Skeleton:  skeleton,
+		OwnerID:   &ownerID,
+		OwnerType: ownerType,
+		Version:   repo.Version,
+		UpdatedAt: time.Now(),
+	}
+	if name != repo.Name {
+		patch.PreviousName = repo.Name
+	}
+
+	transferred, err := s.Repository.PatchOne(ctx, id, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.moveAddress(ctx, repo, transferred); err != nil {
+		return nil, err
+	}
+	if err := s.dropGrants(ctx, repo, transferred, transfer.KeepCollaborators); err != nil {
+		return nil, err
+	}
+
+	err = s.Audit.Record(ctx, &model.AuditEntry{
+		RepoID:  repo.ID,
+		ActorID: caller.ID,
+		Action:  model.AuditRepoTransferred,
+		Details: map[string]string{
+			"from": repo.OwnerID + "/" + repo.Name,
+			"to":   transferred.OwnerID + "/" + transferred.Name,
+		},
+		CreatedAt: transferred.UpdatedAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("record transfer: %w", err)
+	}
+
+	return transferred, nil
+}
+
+// transferTarget resolves the new owner of a transfer. Repos go to organizations the
+// caller belongs to, or to users that are known. A mistyped user ID would otherwise
+// take the repo out of reach of everyone but admins.
+func (s *RepoServiceImpl) transferTarget(ctx context.Context, caller identity.Caller, newOwner string) (ownerID string, ownerType string, err error) {
+	if newOwner == "" {
+		return "", "", repoerr.Validation(repoerr.Violation{Field: "newOwner", Message: "is required"})
+	}
+
+	org, err := s.Organizations.FindByLogin(ctx, newOwner)
+	switch {
+	case err == nil:
+		if _, ok := org.Member(caller.ID); !ok && !caller.HasRole(string(authz.RoleAdmin)) {
+			return "", "", repoerr.PermissionDenied("not a member of organization %q", org.Login)
+		}
+		return org.ID.Hex(), model.OwnerOrganization, nil
+	case !errors.Is(err, repoerr.ErrNotFound):
+		return "", "", err
+	}
+
+	unknown := repoerr.Validation(repoerr.Violation{Field: "newOwner", Message: "is not an organization login or user id"})
+	if strings.ContainsAny(newOwner, "/ \t\r\n") {
+		return "", "", unknown
+	}
+
+	known, err := s.knownUser(ctx, caller, newOwner)
+	if err != nil {
+		return "", "", err
+	}
+	if !known {
+		return "", "", unknown
+	}
+
+	return newOwner, model.OwnerUser, nil
+}
+
+// dropGrants removes the roles that do not survive a change of owner: team grants
+// belong to the former organization, and a new owning user needs no collaborator role
+func (s *RepoServiceImpl) dropGrants(ctx context.Context, before *model.PrivateRepoModel, after *model.PrivateRepoModel, keepCollaborators bool) error {
+	if _, ok := before.OrganizationID(); ok {
+		if err := s.Teams.RemoveRepoFromAll(ctx, before.ID); err != nil {
+			return fmt.Errorf("remove team roles: %w", err)
+		}
+	}
+
+	if !keepCollaborators {
+		if err := s.Collaborators.RemoveByRepo(ctx, before.ID); err != nil {
+			return fmt.Errorf("remove collaborators: %w", err)
+		}
+		return nil
+	}
+
+	if after.EffectiveOwnerType() == model.OwnerUser {
+		err := s.Collaborators.Remove(ctx, before.ID, after.OwnerID)
+		if err != nil && !errors.Is(err, repoerr.ErrNotFound) {
+			return fmt.Errorf("remove collaborator: %w", err)
+		}
+	}
+
+	return nil
+}
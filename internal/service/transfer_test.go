@@ -0,0 +1,149 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransfer_ToOrganization(t *testing.T) {
+	repoService := newOrgService(t)
+	repo, err := repoService.Create(as("member"), &public_repo.CreateRepoModel{Name: "tools", Visibility: model.VisibilityPrivate})
+	require.NoError(t, err)
+	_, err = repoService.AddCollaborator(as("member"), repo.ID.Hex(), "helper", model.CollaboratorWrite)
+	require.NoError(t, err)
+
+	transferred, err := repoService.Transfer(as("member"), repo.ID.Hex(), &model.RepoTransfer{NewOwner: "acme", NewName: "Platform-Tools"})
+
+	require.NoError(t, err)
+	assert.Equal(t, model.OwnerOrganization, transferred.OwnerType)
+	assert.Equal(t, "platform-tools", transferred.Name)
+
+	found, err := repoService.FindByFullName(as("owner"), "acme/platform-tools")
+	assert.Nil(t, err)
+	assert.Equal(t, repo.ID, found.ID)
+
+	// The old address redirects, collaborators were not kept
+	found, err = repoService.FindByFullName(as("owner"), "member/tools")
+	assert.Nil(t, err)
+	assert.Equal(t, repo.ID, found.ID)
+
+	_, err = repoService.FindByFullName(as("helper"), "acme/platform-tools")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+
+	entries, _ := repoService.Audit.ListByRepo(context.TODO(), repo.ID.Hex())
+	assert.Equal(t, model.AuditRepoTransferred, entries[len(entries)-1].Action)
+	assert.Equal(t, map[string]string{"from": "member/tools", "to": transferred.OwnerID + "/platform-tools"}, entries[len(entries)-1].Details)
+}
+
+func TestTransfer_KeepCollaborators(t *testing.T) {
+	repoService := newOrgService(t)
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	_, err = repoService.AddCollaborator(as("owner"), repo.ID.Hex(), "helper", model.CollaboratorWrite)
+	require.NoError(t, err)
+	_, err = repoService.AddCollaborator(as("owner"), repo.ID.Hex(), "heir", model.CollaboratorAdmin)
+	require.NoError(t, err)
+
+	_, err = repoService.Transfer(as("owner"), repo.ID.Hex(), &model.RepoTransfer{NewOwner: "heir", KeepCollaborators: true})
+	require.NoError(t, err)
+
+	// The new owner needs no collaborator role
+	collaborators, err := repoService.ListCollaborators(as("heir"), repo.ID.Hex())
+	assert.Nil(t, err)
+	require.Len(t, collaborators, 1)
+	assert.Equal(t, "helper", collaborators[0].UserID)
+
+	_, err = repoService.Patch(as("owner"), repo.ID.Hex(), &model.RepoPatch{})
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+}
+
+func TestTransfer_Error(t *testing.T) {
+	repoService := newOrgService(t)
+	repo, err := repoService.Create(as("member"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	_, err = repoService.Create(as("member"), &public_repo.CreateRepoModel{Name: "tools", Organization: "acme"})
+	require.NoError(t, err)
+	_, err = repoService.AddCollaborator(as("member"), repo.ID.Hex(), "repo-admin", model.CollaboratorAdmin)
+	require.NoError(t, err)
+
+	_, err = repoService.Transfer(as("member"), repo.ID.Hex(), &model.RepoTransfer{NewOwner: "acme"})
+	assert.ErrorIs(t, err, repoerr.ErrConflict)
+
+	_, err = repoService.Transfer(as("member"), repo.ID.Hex(), &model.RepoTransfer{NewOwner: "member"})
+	assert.Equal(t, "newOwner", repoerr.ViolationsOf(err)[0].Field)
+
+	_, err = repoService.Transfer(as("member"), repo.ID.Hex(), &model.RepoTransfer{NewOwner: "a/b"})
+	assert.Equal(t, "newOwner", repoerr.ViolationsOf(err)[0].Field)
+
+	// A user nothing is known of could be a typo
+	_, err = repoService.Transfer(as("member"), repo.ID.Hex(), &model.RepoTransfer{NewOwner: "nobody"})
+	assert.Equal(t, "newOwner", repoerr.ViolationsOf(err)[0].Field)
+
+	_, err = repoService.Transfer(as("repo-admin"), repo.ID.Hex(), &model.RepoTransfer{NewOwner: "repo-admin"})
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+
+	// Only members move repos into an organization
+	personal, err := repoService.Create(as("someone"), &public_repo.CreateRepoModel{Name: "personal"})
+	require.NoError(t, err)
+	_, err = repoService.Transfer(as("someone"), personal.ID.Hex(), &model.RepoTransfer{NewOwner: "acme"})
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+}
+
+func TestTransfer_NewRepoTakesOverAddress(t *testing.T) {
+	repoService := newOrgService(t)
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	_, err = repoService.Transfer(as("owner"), repo.ID.Hex(), &model.RepoTransfer{NewOwner: "acme"})
+	require.NoError(t, err)
+
+	// The former address is kept for the redirect until explicitly reused
+	_, err = repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	assert.Equal(t, "name", repoerr.ViolationsOf(err)[0].Field)
+
+	replacement, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools", ReuseName: true})
+	require.NoError(t, err)
+
+	found, err := repoService.FindByFullName(as("owner"), "owner/tools")
+	assert.Nil(t, err)
+	assert.Equal(t, replacement.ID, found.ID)
+
+	// Purging the transferred repo drops its redirects
+	assert.Nil(t, repoService.Delete(as("owner"), repo))
+	assert.Nil(t, repoService.Delete(as("owner"), replacement))
+	_, err = repoService.PurgeTrash(context.TODO(), time.Now().Add(repoService.TrashRetention+time.Minute))
+	require.NoError(t, err)
+	_, err = repoService.FindByFullName(as("owner"), "owner/tools")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+// A former name of the new owner's repos keeps redirecting unless the transfer reuses it
+func TestTransfer_FormerName(t *testing.T) {
+	repoService := newOrgService(t)
+	old, err := repoService.Create(as("bob"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	_, err = repoService.Rename(as("bob"), old.ID.Hex(), "kit", false)
+	require.NoError(t, err)
+	repo, err := repoService.Create(as("alice"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+
+	_, err = repoService.Transfer(as("alice"), repo.ID.Hex(), &model.RepoTransfer{NewOwner: "bob"})
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+
+	found, err := repoService.FindByFullName(as("someone"), "bob/tools")
+	require.NoError(t, err)
+	assert.Equal(t, old.ID, found.ID)
+
+	_, err = repoService.Transfer(as("alice"), repo.ID.Hex(), &model.RepoTransfer{NewOwner: "bob", ReuseName: true})
+	require.NoError(t, err)
+
+	found, err = repoService.FindByFullName(as("someone"), "bob/tools")
+	require.NoError(t, err)
+	assert.Equal(t, repo.ID, found.ID)
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/authz"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+)
+
+// TrashService empties the trash, it is run in the background rather than on behalf
+// of a caller
+type TrashService interface {
+	PurgeTrash(ctx context.Context, now time.Time) (int, error)
+}
+
+// Restore takes a repo back out of the trash with its name, collaborators and grants.
+// Whoever may delete a repo may restore it.
+func (s *RepoServiceImpl) Restore(ctx context.Context, id string) (*model.PrivateRepoModel, error) {
+	repo, err := s.Repository.FindTrashedById(ctx, id)
+	repo, err = s.visible(ctx, repo, err)
+	if err != nil {
+		return nil, err
+	}
+	if !time.Now().Before(s.purgeAt(repo)) {
+		return nil, repoerr.NotFound("repo not found")
+	}
+
+	caller, err := s.authorize(ctx, authz.ActionDelete, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	restored, err := s.Repository.Restore(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.countFork(ctx, restored, 1); err != nil {
+		return nil, err
+	}
+
+	restored, err = s.splitNetwork(ctx, restored)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Audit.Record(ctx, &model.AuditEntry{
+		RepoID:    restored.ID,
+		ActorID:   caller.ID,
+		Action:    model.AuditRepoRestored,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("record restore: %w", err)
+	}
+
+	return restored, nil
+}
+
+// PurgeTrash permanently removes the repos that have been in the trash longer than
+// TrashRetention along with their collaborators, team grants, redirects, stars and
+// watchers. Their forks are handed down to their parent. It returns how many repos
+// were removed.
+func (s *RepoServiceImpl) PurgeTrash(ctx context.Context, now time.Time) (int, error) {
+	expired, err := s.Repository.ListTrashed(ctx, now.Add(-s.TrashRetention))
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, repo := range expired {
+		if err := s.purge(ctx, repo); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+
+	return purged, nil
+}
+
+// purge removes what depends on a repo before the repo itself, so a purge that fails
+// halfway leaves the repo in the trash and the next run picks it up again. Every step
+// can be repeated.
+func (s *RepoServiceImpl) purge(ctx context.Context, repo *model.PrivateRepoModel) error {
+	if err := s.reparentForks(ctx, repo); err != nil {
+		return fmt.Errorf("reparent forks: %w", err)
+	}
+
+	if err := s.Collaborators.RemoveByRepo(ctx, repo.ID); err != nil {
+		return fmt.Errorf("remove collaborators: %w", err)
+	}
+
+	if err := s.Teams.RemoveRepoFromAll(ctx, repo.ID); err != nil {
+		return fmt.Errorf("remove team roles: %w", err)
+	}
+
+	if err := s.Redirects.RemoveByRepo(ctx, repo.ID); err != nil {
+		return fmt.Errorf("remove redirects: %w", err)
+	}
+
+	if err := s.Subscriptions.RemoveByRepo(ctx, repo.ID); err != nil {
+		return fmt.Errorf("remove subscriptions: %w", err)
+	}
+
+	if err := s.Repository.DeleteOne(ctx, repo); err != nil && !errors.Is(err, repoerr.ErrNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// purgeAt is when a trashed repo becomes eligible for purging
+func (s *RepoServiceImpl) purgeAt(repo *model.PrivateRepoModel) time.Time {
+	return repo.DeletedAt.Add(s.TrashRetention)
+}
@@ -0,0 +1,173 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/model"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repository"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/service"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestDelete_MovesToTrash(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+
+	require.NoError(t, repoService.Delete(as("owner"), repo))
+
+	_, err = repoService.FindById(as("owner"), repo.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+	_, err = repoService.FindByFullName(as("owner"), "owner/tools")
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+	page, err := repoService.List(as("owner"), &model.RepoListQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, page.Repos)
+
+	entries, _ := repoService.Audit.ListByRepo(context.TODO(), repo.ID.Hex())
+	require.Len(t, entries, 1)
+	assert.Equal(t, model.AuditRepoDeleted, entries[0].Action)
+	assert.NotEmpty(t, entries[0].Details["purgeAt"])
+}
+
+func TestDelete_ReservesName(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	require.NoError(t, repoService.Delete(as("owner"), repo))
+
+	_, err = repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+	assert.Equal(t, "name", repoerr.ViolationsOf(err)[0].Field)
+
+	// Other owners are not affected
+	_, err = repoService.Create(as("someone"), &public_repo.CreateRepoModel{Name: "tools"})
+	assert.Nil(t, err)
+}
+
+func TestRestore(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	_, err = repoService.AddCollaborator(as("owner"), repo.ID.Hex(), "friend", model.CollaboratorWrite)
+	require.NoError(t, err)
+	require.NoError(t, repoService.Delete(as("owner"), repo))
+
+	_, err = repoService.Restore(as("friend"), repo.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrPermissionDenied)
+
+	restored, err := repoService.Restore(as("owner"), repo.ID.Hex())
+
+	require.NoError(t, err)
+	assert.False(t, restored.Trashed())
+	found, err := repoService.FindByFullName(as("owner"), "owner/tools")
+	require.NoError(t, err)
+	assert.Equal(t, repo.ID, found.ID)
+	collaborators, err := repoService.ListCollaborators(as("owner"), repo.ID.Hex())
+	require.NoError(t, err)
+	assert.Len(t, collaborators, 1)
+
+	_, err = repoService.Restore(as("owner"), repo.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func TestRestore_Error_Hidden(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools", Visibility: model.VisibilityPrivate})
+	require.NoError(t, err)
+	require.NoError(t, repoService.Delete(as("owner"), repo))
+
+	_, err = repoService.Restore(as("someone"), repo.ID.Hex())
+
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func TestRestore_Error_RetentionOver(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	require.NoError(t, repoService.Delete(as("owner"), repo))
+
+	repoService.TrashRetention = 0
+	_, err = repoService.Restore(as("owner"), repo.ID.Hex())
+
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+}
+
+func TestPurgeTrash(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repo, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	_, err = repoService.AddCollaborator(as("owner"), repo.ID.Hex(), "friend", model.CollaboratorWrite)
+	require.NoError(t, err)
+	require.NoError(t, repoService.Delete(as("owner"), repo))
+
+	purged, err := repoService.PurgeTrash(context.TODO(), time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 0, purged)
+
+	purged, err = repoService.PurgeTrash(context.TODO(), time.Now().Add(repoService.TrashRetention+time.Minute))
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+	_, err = repoService.Restore(as("owner"), repo.ID.Hex())
+	assert.ErrorIs(t, err, repoerr.ErrNotFound)
+	collaborators, _ := repoService.Collaborators.ListByRepo(context.TODO(), repo.ID)
+	assert.Empty(t, collaborators)
+
+	// The name is free again
+	_, err = repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	assert.Nil(t, err)
+}
+
+// failingSubscriptions fails the first cleanup of a purged repo
+type failingSubscriptions struct {
+	repository.SubscriptionRepository
+	failed bool
+}
+
+func (f *failingSubscriptions) RemoveByRepo(ctx context.Context, repoID primitive.ObjectID) error {
+	if !f.failed {
+		f.failed = true
+		return assert.AnError
+	}
+
+	return f.SubscriptionRepository.RemoveByRepo(ctx, repoID)
+}
+
+// A purge that fails halfway keeps the repo in the trash for the next run
+func TestPurgeTrash_RetriesAfterFailure(t *testing.T) {
+	repoService := service.NewRepoService(repository.NewMemoryRepoRepository())
+	repoService.Subscriptions = &failingSubscriptions{SubscriptionRepository: repository.NewMemorySubscriptionRepository()}
+	parent, err := repoService.Create(as("owner"), &public_repo.CreateRepoModel{Name: "tools"})
+	require.NoError(t, err)
+	repo, err := repoService.Fork(as("owner"), parent.ID.Hex(), &model.RepoFork{Name: "fork"})
+	require.NoError(t, err)
+	_, err = repoService.Fork(as("someone"), repo.ID.Hex(), &model.RepoFork{})
+	require.NoError(t, err)
+	require.NoError(t, repoService.Star(as("someone"), repo.ID.Hex()))
+	require.NoError(t, repoService.Delete(as("owner"), repo))
+	later := time.Now().Add(repoService.TrashRetention + time.Minute)
+
+	_, err = repoService.PurgeTrash(context.TODO(), later)
+	assert.ErrorIs(t, err, assert.AnError)
+
+	purged, err := repoService.PurgeTrash(context.TODO(), later)
+	require.NoError(t, err)
+	assert.Equal(t, 1, purged)
+
+	stargazers, err := repoService.Subscriptions.ListByRepo(context.TODO(), repo.ID, model.SubscriptionStar, &model.SubscriptionQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, stargazers.Subscriptions)
+
+	// The fork of the purged repo was counted on the parent once
+	found, err := repoService.FindById(as("owner"), parent.ID.Hex())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), found.ForksCount)
+}
@@ -0,0 +1,262 @@
+// Package validation enforces the `binding` struct tags of the public models.
+//
+// A tag holds comma separated rules:
+//
+//	required     the field must not be its zero value, blank strings count as zero
+//	min=N        minimum string length in characters, slice length or number value
+//	max=N        maximum, same units as min
+//	pattern=RE   strings must match RE; it has to be the last rule so RE may contain commas
+//
+// Empty optional fields skip min, max and pattern. The tags of a struct type are
+// parsed once, when the first value of it is validated; a tag that does not parse
+// fails every validation of the type with ErrInvalidTag.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+)
+
+const tagName = "binding"
+
+// ErrInvalidTag means a model carries a binding tag that does not parse, a bug of
+// the model rather than of the request
+var ErrInvalidTag = errors.New("invalid binding tag")
+
+var structs sync.Map // Parsed *structRules, keyed by reflect.Type
+
+type structRules struct {
+	fields []fieldRules
+	err    error
+}
+
+type fieldRules struct {
+	index int
+	name  string // JSON name
+	rules []rule
+}
+
+type rule struct {
+	key     string
+	arg     string
+	bound   float64        // Of min and max
+	pattern *regexp.Regexp // Of pattern
+}
+
+// Struct validates v, a struct or a pointer to one, and returns a validation error
+// listing every failing field by its JSON name. Anything else is accepted as is.
+func Struct(v interface{}) error {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	violations, err := validateStruct(value, "")
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		return repoerr.Validation(violations...)
+	}
+
+	return nil
+}
+
+func validateStruct(value reflect.Value, prefix string) ([]repoerr.Violation, error) {
+	parsed := rulesOf(value.Type())
+	if parsed.err != nil {
+		return nil, parsed.err
+	}
+
+	violations := []repoerr.Violation{}
+	for _, field := range parsed.fields {
+		name := prefix + field.name
+		fieldValue := value.Field(field.index)
+
+		violations = append(violations, validateField(fieldValue, name, field.rules)...)
+
+		if fieldValue.Kind() == reflect.Pointer && !fieldValue.IsNil() {
+			fieldValue = fieldValue.Elem()
+		}
+		if fieldValue.Kind() == reflect.Struct {
+			nested, err := validateStruct(fieldValue, name+".")
+			if err != nil {
+				return nil, err
+			}
+			violations = append(violations, nested...)
+		}
+	}
+
+	return violations, nil
+}
+
+// rulesOf parses the binding tags of the exported fields of a struct type, once
+func rulesOf(t reflect.Type) *structRules {
+	if cached, ok := structs.Load(t); ok {
+		return cached.(*structRules)
+	}
+
+	parsed := &structRules{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		rules, err := parseRules(field.Tag.Get(tagName))
+		if err != nil {
+			parsed = &structRules{err: fmt.Errorf("%w on %s.%s: %v", ErrInvalidTag, t, field.Name, err)}
+			break
+		}
+		parsed.fields = append(parsed.fields, fieldRules{index: i, name: jsonName(field), rules: rules})
+	}
+
+	cached, _ := structs.LoadOrStore(t, parsed)
+	return cached.(*structRules)
+}
+
+func parseRules(tag string) ([]rule, error) {
+	rules := []rule{}
+	for _, text := range splitRules(tag) {
+		key, arg, _ := strings.Cut(text, "=")
+		parsed := rule{key: key, arg: arg}
+
+		switch key {
+		case "required":
+		case "min", "max":
+			bound, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%s=%q is not a number", key, arg)
+			}
+			parsed.bound = bound
+		case "pattern":
+			pattern, err := regexp.Compile(arg)
+			if err != nil {
+				return nil, err
+			}
+			parsed.pattern = pattern
+		default:
+			return nil, fmt.Errorf("unknown rule %q", key)
+		}
+
+		rules = append(rules, parsed)
+	}
+
+	return rules, nil
+}
+
+func validateField(value reflect.Value, name string, rules []rule) []repoerr.Violation {
+	violations := []repoerr.Violation{}
+
+	empty := isEmpty(value)
+	for _, rule := range rules {
+		var message string
+		switch {
+		case rule.key == "required":
+			if empty {
+				return []repoerr.Violation{{Field: name, Message: "is required"}}
+			}
+		case empty:
+		case rule.key == "min" || rule.key == "max":
+			message = checkBound(value, rule)
+		case rule.key == "pattern":
+			message = checkPattern(value, rule)
+		}
+
+		if message != "" {
+			violations = append(violations, repoerr.Violation{Field: name, Message: message})
+		}
+	}
+
+	return violations
+}
+
+// splitRules splits a tag on commas, except within the trailing pattern rule
+func splitRules(tag string) []string {
+	rules := []string{}
+	for tag != "" {
+		if strings.HasPrefix(tag, "pattern=") {
+			return append(rules, tag)
+		}
+
+		rule, rest, _ := strings.Cut(tag, ",")
+		if rule = strings.TrimSpace(rule); rule != "" {
+			rules = append(rules, rule)
+		}
+		tag = strings.TrimLeft(rest, " ")
+	}
+
+	return rules
+}
+
+func checkBound(value reflect.Value, rule rule) string {
+	var actual float64
+	unit := ""
+	switch value.Kind() {
+	case reflect.String:
+		actual, unit = float64(utf8.RuneCountInString(value.String())), " characters"
+	case reflect.Slice, reflect.Map, reflect.Array:
+		actual, unit = float64(value.Len()), " items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		actual = float64(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		actual = float64(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		actual = value.Float()
+	default:
+		return ""
+	}
+
+	switch {
+	case rule.key == "min" && actual < rule.bound:
+		return fmt.Sprintf("must be at least %s%s", rule.arg, unit)
+	case rule.key == "max" && actual > rule.bound:
+		return fmt.Sprintf("must be at most %s%s", rule.arg, unit)
+	}
+
+	return ""
+}
+
+func checkPattern(value reflect.Value, rule rule) string {
+	if value.Kind() != reflect.String {
+		return ""
+	}
+
+	if !rule.pattern.MatchString(value.String()) {
+		return fmt.Sprintf("must match %s", rule.arg)
+	}
+
+	return ""
+}
+
+func isEmpty(value reflect.Value) bool {
+	if value.Kind() == reflect.String {
+		return strings.TrimSpace(value.String()) == ""
+	}
+
+	return value.IsZero()
+}
+
+func jsonName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return field.Name
+	}
+
+	return name
+}
@@ -0,0 +1,95 @@
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/repoerr"
+	"github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/internal/validation"
+	public_repo "github.com/Bit-Bridge-Source/BitBridge-RepoService-Go/public"
+	"github.com/stretchr/testify/assert"
+)
+
+type address struct {
+	City string `json:"city" binding:"required"`
+}
+
+type tagged struct {
+	Name    string   `json:"name" binding:"required,min=3,max=5"`
+	Slug    string   `json:"slug" binding:"pattern=^[a-z]{1,3}(-[a-z]+)?$"`
+	Tags    []string `json:"tags" binding:"max=2"`
+	Count   int      `json:"count" binding:"min=1"`
+	Address *address `json:"address"`
+	NoJSON  string   `binding:"required"`
+}
+
+func TestStruct_Valid(t *testing.T) {
+	err := validation.Struct(&tagged{Name: "abcd", Slug: "ab-cd", Tags: []string{"a"}, Count: 2, Address: &address{City: "x"}, NoJSON: "x"})
+
+	assert.Nil(t, err)
+}
+
+func TestStruct_Violations(t *testing.T) {
+	err := validation.Struct(&tagged{Name: "ab", Slug: "ABC", Tags: []string{"a", "b", "c"}, Count: -1, Address: &address{}})
+
+	assert.ErrorIs(t, err, repoerr.ErrValidationFailed)
+	assert.Equal(t, []repoerr.Violation{
+		{Field: "name", Message: "must be at least 3 characters"},
+		{Field: "slug", Message: "must match ^[a-z]{1,3}(-[a-z]+)?$"},
+		{Field: "tags", Message: "must be at most 2 items"},
+		{Field: "count", Message: "must be at least 1"},
+		{Field: "address.city", Message: "is required"},
+		{Field: "NoJSON", Message: "is required"},
+	}, repoerr.ViolationsOf(err))
+}
+
+func TestStruct_OptionalEmptySkipsRules(t *testing.T) {
+	err := validation.Struct(&tagged{Name: "abc", NoJSON: "x"})
+
+	assert.Nil(t, err)
+}
+
+func TestStruct_PublicModels(t *testing.T) {
+	err := validation.Struct(&public_repo.CreateRepoModel{Name: " "})
+
+	assert.Equal(t, []repoerr.Violation{{Field: "name", Message: "is required"}}, repoerr.ViolationsOf(err))
+}
+
+type badRule struct {
+	Name string `json:"name" binding:"required,lowercase"`
+}
+
+type badBound struct {
+	Name string `json:"name" binding:"max=ten"`
+}
+
+type badPattern struct {
+	Name string `json:"name" binding:"pattern=[a-"`
+}
+
+// Broken tags fail validation instead of panicking, whether the field is set or not
+func TestStruct_Error_InvalidTag(t *testing.T) {
+	for _, v := range []interface{}{&badRule{}, &badBound{Name: "x"}, &badPattern{Name: "x"}, &struct{ Nested badRule }{}} {
+		err := validation.Struct(v)
+
+		assert.ErrorIs(t, err, validation.ErrInvalidTag)
+		assert.NotErrorIs(t, err, repoerr.ErrValidationFailed)
+	}
+}
+
+// Every public model parses
+func TestStruct_PublicModelTags(t *testing.T) {
+	for _, v := range []interface{}{
+		&public_repo.CreateRepoModel{}, &public_repo.VisibilityModel{}, &public_repo.RenameRepoModel{},
+		&public_repo.ForkRepoModel{}, &public_repo.TransferRepoModel{}, &public_repo.UpdateRepoModel{},
+		&public_repo.ValidateNameModel{}, &public_repo.AddCollaboratorModel{}, &public_repo.CollaboratorRoleModel{},
+		&public_repo.CreateOrganizationModel{}, &public_repo.OrgMemberRoleModel{}, &public_repo.CreateTeamModel{},
+		&public_repo.TeamRepoModel{},
+	} {
+		assert.NotErrorIs(t, validation.Struct(v), validation.ErrInvalidTag, "%T", v)
+	}
+}
+
+func TestStruct_NonStruct(t *testing.T) {
+	assert.Nil(t, validation.Struct(map[string]string{}))
+	assert.Nil(t, validation.Struct((*tagged)(nil)))
+}
@@ -7,14 +7,156 @@ import (
 )
 
 type PublicRepoModel struct {
-	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
-	Name      string             `json:"name" bson:"name"`
-	CreatedAt time.Time          `json:"created_at" bson:"created_at"`
-	UpdatedAt time.Time          `json:"updated_at" bson:"updated_at"`
+	ID            primitive.ObjectID  `json:"id" bson:"_id,omitempty"`
+	Name          string              `json:"name" bson:"name"`
+	CreatedAt     time.Time           `json:"created_at" bson:"created_at"`
+	UpdatedAt     time.Time           `json:"updated_at" bson:"updated_at"`
+	Visibility    string              `json:"visibility" bson:"visibility"`
+	Archived      bool                `json:"archived" bson:"archived"`
+	ParentID      *primitive.ObjectID `json:"parentId,omitempty" bson:"parent_id,omitempty"` // Set for forks
+	ForksCount    int64               `json:"forksCount" bson:"forks_count"`
+	StarsCount    int64               `json:"starsCount" bson:"stars_count"`
+	WatchersCount int64               `json:"watchersCount" bson:"watchers_count"`
+	Topics        []string            `json:"topics,omitempty" bson:"topics,omitempty"`
+	IsTemplate    bool                `json:"isTemplate" bson:"is_template"`
+	TemplateID    *primitive.ObjectID `json:"templateId,omitempty" bson:"template_id,omitempty"` // Set for repos created from a template
 }
 
 type CreateRepoModel struct {
-	OwnerID     string `json:"ownerId"`                 // Owner's ID
+	Name         string            `json:"name" binding:"required"`                                  // Repo name
+	Description  string            `json:"description"`                                              // Repo description, defaults to the template's
+	Visibility   string            `json:"visibility" binding:"pattern=^(public|internal|private)$"` // Defaults to the template's, or public
+	Organization string            `json:"organization"`                                             // Login of the owning organization, empty for a personal repo
+	ReuseName    bool              `json:"reuseName"`                                                // Take over a former name of another repo, ending its redirect
+	Template     string            `json:"template"`                                                 // Id or "owner/name" of a template repo to start from
+	Topics       []string          `json:"topics"`                                                   // Default to the template's topics
+	Properties   map[string]string `json:"properties"`                                               // Custom properties, override the template's of the same key
+}
+
+type VisibilityModel struct {
+	Visibility string `json:"visibility" binding:"required,pattern=^(public|internal|private)$"` // public, internal or private
+}
+
+type RenameRepoModel struct {
+	Name      string `json:"name" binding:"required"` // New repo name
+	ReuseName bool   `json:"reuseName"`               // Take over a former name of another repo, ending its redirect
+}
+
+type ForkRepoModel struct {
+	Name         string `json:"name"`         // Defaults to the name of the parent
+	Organization string `json:"organization"` // Login of the organization to fork into, empty for a personal fork
+}
+
+type TransferRepoModel struct {
+	NewOwner          string `json:"newOwner" binding:"required"` // Organization login or user ID
+	NewName           string `json:"newName"`                     // Empty keeps the current name
+	KeepCollaborators bool   `json:"keepCollaborators"`           // Collaborators are removed unless set
+	ReuseName         bool   `json:"reuseName"`                   // Take over a former name of another repo of the new owner, ending its redirect
+}
+
+type UpdateRepoModel struct {
 	Name        string `json:"name" binding:"required"` // Repo name
 	Description string `json:"description"`             // Repo description
 }
+
+type ValidateNameModel struct {
+	Name string `json:"name"` // Name as typed by the user
+}
+
+type NameValidationModel struct {
+	Name   string            `json:"name"`             // Name as it would be stored
+	Valid  bool              `json:"valid"`            // Whether a repo could be created with it
+	Fields []FieldErrorModel `json:"fields,omitempty"` // Rules the name breaks
+}
+
+type RepoListModel struct {
+	Repos      []interface{} `json:"repos"`                 // PublicRepoModel, or the full repo for its owner
+	NextCursor string        `json:"next_cursor,omitempty"` // Pass as ?cursor= to fetch the next page
+}
+
+type ErrorModel struct {
+	Error  string            `json:"error"`            // Human readable error message
+	Fields []FieldErrorModel `json:"fields,omitempty"` // Per-field validation errors
+}
+
+type FieldErrorModel struct {
+	Field   string `json:"field"`   // JSON name of the offending field
+	Message string `json:"message"` // What is wrong with it
+}
+
+type CollaboratorModel struct {
+	UserID    string    `json:"userId"`
+	Role      string    `json:"role"` // read, triage, write, maintain or admin
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type AddCollaboratorModel struct {
+	UserID string `json:"userId" binding:"required"`                                            // User to grant the role to
+	Role   string `json:"role" binding:"required,pattern=^(read|triage|write|maintain|admin)$"` // read, triage, write, maintain or admin
+}
+
+type CollaboratorRoleModel struct {
+	Role string `json:"role" binding:"required,pattern=^(read|triage|write|maintain|admin)$"` // read, triage, write, maintain or admin
+}
+
+type CollaboratorListModel struct {
+	Collaborators []CollaboratorModel `json:"collaborators"`
+}
+
+type SubscriptionModel struct {
+	UserID    string    `json:"userId"`
+	CreatedAt time.Time `json:"created_at"` // When the user starred or started watching the repo
+}
+
+type SubscriptionListModel struct {
+	Subscriptions []SubscriptionModel `json:"subscriptions"`
+	NextCursor    string              `json:"next_cursor,omitempty"` // Pass as ?cursor= to fetch the next page
+}
+
+type OrganizationModel struct {
+	ID        primitive.ObjectID `json:"id"`
+	Login     string             `json:"login"`
+	Name      string             `json:"name"`
+	Members   []OrgMemberModel   `json:"members"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+type OrgMemberModel struct {
+	UserID string `json:"userId"`
+	Role   string `json:"role"` // owner or member
+}
+
+type CreateOrganizationModel struct {
+	Login string `json:"login" binding:"required"` // Unique, follows the repo naming rules
+	Name  string `json:"name"`                     // Display name, defaults to the login
+}
+
+type OrgMemberRoleModel struct {
+	Role string `json:"role" binding:"required,pattern=^(owner|member)$"` // owner or member
+}
+
+type TeamModel struct {
+	ID        primitive.ObjectID `json:"id"`
+	Slug      string             `json:"slug"`
+	Name      string             `json:"name"`
+	Members   []string           `json:"members"`
+	Repos     []TeamRepoModel    `json:"repos"`
+	CreatedAt time.Time          `json:"created_at"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+type TeamRepoModel struct {
+	RepoID primitive.ObjectID `json:"repoId"`
+	Role   string             `json:"role"` // read, triage, write, maintain or admin
+}
+
+type CreateTeamModel struct {
+	Slug string `json:"slug" binding:"required"` // Unique within the organization, follows the repo naming rules
+	Name string `json:"name"`                    // Display name, defaults to the slug
+}
+
+type TeamListModel struct {
+	Teams []TeamModel `json:"teams"`
+}
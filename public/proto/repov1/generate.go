@@ -0,0 +1,4 @@
+// Package repov1 contains the gRPC API of the repo service
+package repov1
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative repo.proto
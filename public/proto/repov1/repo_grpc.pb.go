@@ -0,0 +1,1712 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: repo.proto
+
+package repov1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	RepoService_CreateRepo_FullMethodName        = "/bitbridge.repo.v1.RepoService/CreateRepo"
+	RepoService_GetRepo_FullMethodName           = "/bitbridge.repo.v1.RepoService/GetRepo"
+	RepoService_UpdateRepo_FullMethodName        = "/bitbridge.repo.v1.RepoService/UpdateRepo"
+	RepoService_DeleteRepo_FullMethodName        = "/bitbridge.repo.v1.RepoService/DeleteRepo"
+	RepoService_ListRepos_FullMethodName         = "/bitbridge.repo.v1.RepoService/ListRepos"
+	RepoService_SetRepoVisibility_FullMethodName = "/bitbridge.repo.v1.RepoService/SetRepoVisibility"
+	RepoService_RenameRepo_FullMethodName        = "/bitbridge.repo.v1.RepoService/RenameRepo"
+	RepoService_TransferRepo_FullMethodName      = "/bitbridge.repo.v1.RepoService/TransferRepo"
+	RepoService_RestoreRepo_FullMethodName       = "/bitbridge.repo.v1.RepoService/RestoreRepo"
+	RepoService_ArchiveRepo_FullMethodName       = "/bitbridge.repo.v1.RepoService/ArchiveRepo"
+	RepoService_UnarchiveRepo_FullMethodName     = "/bitbridge.repo.v1.RepoService/UnarchiveRepo"
+)
+
+// RepoServiceClient is the client API for RepoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RepoServiceClient interface {
+	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*Repo, error)
+	GetRepo(ctx context.Context, in *GetRepoRequest, opts ...grpc.CallOption) (*Repo, error)
+	UpdateRepo(ctx context.Context, in *UpdateRepoRequest, opts ...grpc.CallOption) (*Repo, error)
+	// Moves a repo to the trash, it is purged once the retention window is over
+	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposResponse, error)
+	// Only the owner may change visibility, changes are audited
+	SetRepoVisibility(ctx context.Context, in *SetRepoVisibilityRequest, opts ...grpc.CallOption) (*Repo, error)
+	// The old name keeps resolving to the repo, lookups by it set redirected_from.
+	// Changes are audited.
+	RenameRepo(ctx context.Context, in *RenameRepoRequest, opts ...grpc.CallOption) (*Repo, error)
+	// Moves a repo to another owner, the old full name keeps resolving to it.
+	// Changes are audited.
+	TransferRepo(ctx context.Context, in *TransferRepoRequest, opts ...grpc.CallOption) (*Repo, error)
+	// Takes a deleted repo back out of the trash before it is purged
+	RestoreRepo(ctx context.Context, in *RestoreRepoRequest, opts ...grpc.CallOption) (*Repo, error)
+	// Makes a repo read-only, changes to it fail with FAILED_PRECONDITION until it is
+	// unarchived. Changes are audited.
+	ArchiveRepo(ctx context.Context, in *ArchiveRepoRequest, opts ...grpc.CallOption) (*Repo, error)
+	UnarchiveRepo(ctx context.Context, in *UnarchiveRepoRequest, opts ...grpc.CallOption) (*Repo, error)
+}
+
+type repoServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRepoServiceClient(cc grpc.ClientConnInterface) RepoServiceClient {
+	return &repoServiceClient{cc}
+}
+
+func (c *repoServiceClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*Repo, error) {
+	out := new(Repo)
+	err := c.cc.Invoke(ctx, RepoService_CreateRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServiceClient) GetRepo(ctx context.Context, in *GetRepoRequest, opts ...grpc.CallOption) (*Repo, error) {
+	out := new(Repo)
+	err := c.cc.Invoke(ctx, RepoService_GetRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServiceClient) UpdateRepo(ctx context.Context, in *UpdateRepoRequest, opts ...grpc.CallOption) (*Repo, error) {
+	out := new(Repo)
+	err := c.cc.Invoke(ctx, RepoService_UpdateRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServiceClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RepoService_DeleteRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServiceClient) ListRepos(ctx context.Context, in *ListReposRequest, opts ...grpc.CallOption) (*ListReposResponse, error) {
+	out := new(ListReposResponse)
+	err := c.cc.Invoke(ctx, RepoService_ListRepos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServiceClient) SetRepoVisibility(ctx context.Context, in *SetRepoVisibilityRequest, opts ...grpc.CallOption) (*Repo, error) {
+	out := new(Repo)
+	err := c.cc.Invoke(ctx, RepoService_SetRepoVisibility_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServiceClient) RenameRepo(ctx context.Context, in *RenameRepoRequest, opts ...grpc.CallOption) (*Repo, error) {
+	out := new(Repo)
+	err := c.cc.Invoke(ctx, RepoService_RenameRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServiceClient) TransferRepo(ctx context.Context, in *TransferRepoRequest, opts ...grpc.CallOption) (*Repo, error) {
+	out := new(Repo)
+	err := c.cc.Invoke(ctx, RepoService_TransferRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServiceClient) RestoreRepo(ctx context.Context, in *RestoreRepoRequest, opts ...grpc.CallOption) (*Repo, error) {
+	out := new(Repo)
+	err := c.cc.Invoke(ctx, RepoService_RestoreRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServiceClient) ArchiveRepo(ctx context.Context, in *ArchiveRepoRequest, opts ...grpc.CallOption) (*Repo, error) {
+	out := new(Repo)
+	err := c.cc.Invoke(ctx, RepoService_ArchiveRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServiceClient) UnarchiveRepo(ctx context.Context, in *UnarchiveRepoRequest, opts ...grpc.CallOption) (*Repo, error) {
+	out := new(Repo)
+	err := c.cc.Invoke(ctx, RepoService_UnarchiveRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RepoServiceServer is the server API for RepoService service.
+// All implementations must embed UnimplementedRepoServiceServer
+// for forward compatibility
+type RepoServiceServer interface {
+	CreateRepo(context.Context, *CreateRepoRequest) (*Repo, error)
+	GetRepo(context.Context, *GetRepoRequest) (*Repo, error)
+	UpdateRepo(context.Context, *UpdateRepoRequest) (*Repo, error)
+	// Moves a repo to the trash, it is purged once the retention window is over
+	DeleteRepo(context.Context, *DeleteRepoRequest) (*emptypb.Empty, error)
+	ListRepos(context.Context, *ListReposRequest) (*ListReposResponse, error)
+	// Only the owner may change visibility, changes are audited
+	SetRepoVisibility(context.Context, *SetRepoVisibilityRequest) (*Repo, error)
+	// The old name keeps resolving to the repo, lookups by it set redirected_from.
+	// Changes are audited.
+	RenameRepo(context.Context, *RenameRepoRequest) (*Repo, error)
+	// Moves a repo to another owner, the old full name keeps resolving to it.
+	// Changes are audited.
+	TransferRepo(context.Context, *TransferRepoRequest) (*Repo, error)
+	// Takes a deleted repo back out of the trash before it is purged
+	RestoreRepo(context.Context, *RestoreRepoRequest) (*Repo, error)
+	// Makes a repo read-only, changes to it fail with FAILED_PRECONDITION until it is
+	// unarchived. Changes are audited.
+	ArchiveRepo(context.Context, *ArchiveRepoRequest) (*Repo, error)
+	UnarchiveRepo(context.Context, *UnarchiveRepoRequest) (*Repo, error)
+	mustEmbedUnimplementedRepoServiceServer()
+}
+
+// UnimplementedRepoServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRepoServiceServer struct {
+}
+
+func (UnimplementedRepoServiceServer) CreateRepo(context.Context, *CreateRepoRequest) (*Repo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRepo not implemented")
+}
+func (UnimplementedRepoServiceServer) GetRepo(context.Context, *GetRepoRequest) (*Repo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepo not implemented")
+}
+func (UnimplementedRepoServiceServer) UpdateRepo(context.Context, *UpdateRepoRequest) (*Repo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRepo not implemented")
+}
+func (UnimplementedRepoServiceServer) DeleteRepo(context.Context, *DeleteRepoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepo not implemented")
+}
+func (UnimplementedRepoServiceServer) ListRepos(context.Context, *ListReposRequest) (*ListReposResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepos not implemented")
+}
+func (UnimplementedRepoServiceServer) SetRepoVisibility(context.Context, *SetRepoVisibilityRequest) (*Repo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRepoVisibility not implemented")
+}
+func (UnimplementedRepoServiceServer) RenameRepo(context.Context, *RenameRepoRequest) (*Repo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameRepo not implemented")
+}
+func (UnimplementedRepoServiceServer) TransferRepo(context.Context, *TransferRepoRequest) (*Repo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRepo not implemented")
+}
+func (UnimplementedRepoServiceServer) RestoreRepo(context.Context, *RestoreRepoRequest) (*Repo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRepo not implemented")
+}
+func (UnimplementedRepoServiceServer) ArchiveRepo(context.Context, *ArchiveRepoRequest) (*Repo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveRepo not implemented")
+}
+func (UnimplementedRepoServiceServer) UnarchiveRepo(context.Context, *UnarchiveRepoRequest) (*Repo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveRepo not implemented")
+}
+func (UnimplementedRepoServiceServer) mustEmbedUnimplementedRepoServiceServer() {}
+
+// UnsafeRepoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RepoServiceServer will
+// result in compilation errors.
+type UnsafeRepoServiceServer interface {
+	mustEmbedUnimplementedRepoServiceServer()
+}
+
+func RegisterRepoServiceServer(s grpc.ServiceRegistrar, srv RepoServiceServer) {
+	s.RegisterService(&RepoService_ServiceDesc, srv)
+}
+
+func _RepoService_CreateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServiceServer).CreateRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepoService_CreateRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServiceServer).CreateRepo(ctx, req.(*CreateRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoService_GetRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServiceServer).GetRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepoService_GetRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServiceServer).GetRepo(ctx, req.(*GetRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoService_UpdateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServiceServer).UpdateRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepoService_UpdateRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServiceServer).UpdateRepo(ctx, req.(*UpdateRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoService_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServiceServer).DeleteRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepoService_DeleteRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServiceServer).DeleteRepo(ctx, req.(*DeleteRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoService_ListRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReposRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServiceServer).ListRepos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepoService_ListRepos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServiceServer).ListRepos(ctx, req.(*ListReposRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoService_SetRepoVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRepoVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServiceServer).SetRepoVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepoService_SetRepoVisibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServiceServer).SetRepoVisibility(ctx, req.(*SetRepoVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoService_RenameRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServiceServer).RenameRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepoService_RenameRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServiceServer).RenameRepo(ctx, req.(*RenameRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoService_TransferRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServiceServer).TransferRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepoService_TransferRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServiceServer).TransferRepo(ctx, req.(*TransferRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoService_RestoreRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServiceServer).RestoreRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepoService_RestoreRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServiceServer).RestoreRepo(ctx, req.(*RestoreRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoService_ArchiveRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServiceServer).ArchiveRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepoService_ArchiveRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServiceServer).ArchiveRepo(ctx, req.(*ArchiveRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoService_UnarchiveRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnarchiveRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServiceServer).UnarchiveRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RepoService_UnarchiveRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServiceServer).UnarchiveRepo(ctx, req.(*UnarchiveRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RepoService_ServiceDesc is the grpc.ServiceDesc for RepoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RepoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitbridge.repo.v1.RepoService",
+	HandlerType: (*RepoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRepo",
+			Handler:    _RepoService_CreateRepo_Handler,
+		},
+		{
+			MethodName: "GetRepo",
+			Handler:    _RepoService_GetRepo_Handler,
+		},
+		{
+			MethodName: "UpdateRepo",
+			Handler:    _RepoService_UpdateRepo_Handler,
+		},
+		{
+			MethodName: "DeleteRepo",
+			Handler:    _RepoService_DeleteRepo_Handler,
+		},
+		{
+			MethodName: "ListRepos",
+			Handler:    _RepoService_ListRepos_Handler,
+		},
+		{
+			MethodName: "SetRepoVisibility",
+			Handler:    _RepoService_SetRepoVisibility_Handler,
+		},
+		{
+			MethodName: "RenameRepo",
+			Handler:    _RepoService_RenameRepo_Handler,
+		},
+		{
+			MethodName: "TransferRepo",
+			Handler:    _RepoService_TransferRepo_Handler,
+		},
+		{
+			MethodName: "RestoreRepo",
+			Handler:    _RepoService_RestoreRepo_Handler,
+		},
+		{
+			MethodName: "ArchiveRepo",
+			Handler:    _RepoService_ArchiveRepo_Handler,
+		},
+		{
+			MethodName: "UnarchiveRepo",
+			Handler:    _RepoService_UnarchiveRepo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "repo.proto",
+}
+
+const (
+	CollaboratorService_ListCollaborators_FullMethodName   = "/bitbridge.repo.v1.CollaboratorService/ListCollaborators"
+	CollaboratorService_AddCollaborator_FullMethodName     = "/bitbridge.repo.v1.CollaboratorService/AddCollaborator"
+	CollaboratorService_SetCollaboratorRole_FullMethodName = "/bitbridge.repo.v1.CollaboratorService/SetCollaboratorRole"
+	CollaboratorService_RemoveCollaborator_FullMethodName  = "/bitbridge.repo.v1.CollaboratorService/RemoveCollaborator"
+)
+
+// CollaboratorServiceClient is the client API for CollaboratorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CollaboratorServiceClient interface {
+	ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error)
+	AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*Collaborator, error)
+	SetCollaboratorRole(ctx context.Context, in *SetCollaboratorRoleRequest, opts ...grpc.CallOption) (*Collaborator, error)
+	// Collaborators may always remove themselves
+	RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type collaboratorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCollaboratorServiceClient(cc grpc.ClientConnInterface) CollaboratorServiceClient {
+	return &collaboratorServiceClient{cc}
+}
+
+func (c *collaboratorServiceClient) ListCollaborators(ctx context.Context, in *ListCollaboratorsRequest, opts ...grpc.CallOption) (*ListCollaboratorsResponse, error) {
+	out := new(ListCollaboratorsResponse)
+	err := c.cc.Invoke(ctx, CollaboratorService_ListCollaborators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collaboratorServiceClient) AddCollaborator(ctx context.Context, in *AddCollaboratorRequest, opts ...grpc.CallOption) (*Collaborator, error) {
+	out := new(Collaborator)
+	err := c.cc.Invoke(ctx, CollaboratorService_AddCollaborator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collaboratorServiceClient) SetCollaboratorRole(ctx context.Context, in *SetCollaboratorRoleRequest, opts ...grpc.CallOption) (*Collaborator, error) {
+	out := new(Collaborator)
+	err := c.cc.Invoke(ctx, CollaboratorService_SetCollaboratorRole_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collaboratorServiceClient) RemoveCollaborator(ctx context.Context, in *RemoveCollaboratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollaboratorService_RemoveCollaborator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CollaboratorServiceServer is the server API for CollaboratorService service.
+// All implementations must embed UnimplementedCollaboratorServiceServer
+// for forward compatibility
+type CollaboratorServiceServer interface {
+	ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error)
+	AddCollaborator(context.Context, *AddCollaboratorRequest) (*Collaborator, error)
+	SetCollaboratorRole(context.Context, *SetCollaboratorRoleRequest) (*Collaborator, error)
+	// Collaborators may always remove themselves
+	RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCollaboratorServiceServer()
+}
+
+// UnimplementedCollaboratorServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCollaboratorServiceServer struct {
+}
+
+func (UnimplementedCollaboratorServiceServer) ListCollaborators(context.Context, *ListCollaboratorsRequest) (*ListCollaboratorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollaborators not implemented")
+}
+func (UnimplementedCollaboratorServiceServer) AddCollaborator(context.Context, *AddCollaboratorRequest) (*Collaborator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollaborator not implemented")
+}
+func (UnimplementedCollaboratorServiceServer) SetCollaboratorRole(context.Context, *SetCollaboratorRoleRequest) (*Collaborator, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollaboratorRole not implemented")
+}
+func (UnimplementedCollaboratorServiceServer) RemoveCollaborator(context.Context, *RemoveCollaboratorRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollaborator not implemented")
+}
+func (UnimplementedCollaboratorServiceServer) mustEmbedUnimplementedCollaboratorServiceServer() {}
+
+// UnsafeCollaboratorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CollaboratorServiceServer will
+// result in compilation errors.
+type UnsafeCollaboratorServiceServer interface {
+	mustEmbedUnimplementedCollaboratorServiceServer()
+}
+
+func RegisterCollaboratorServiceServer(s grpc.ServiceRegistrar, srv CollaboratorServiceServer) {
+	s.RegisterService(&CollaboratorService_ServiceDesc, srv)
+}
+
+func _CollaboratorService_ListCollaborators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollaboratorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollaboratorServiceServer).ListCollaborators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollaboratorService_ListCollaborators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollaboratorServiceServer).ListCollaborators(ctx, req.(*ListCollaboratorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollaboratorService_AddCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollaboratorServiceServer).AddCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollaboratorService_AddCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollaboratorServiceServer).AddCollaborator(ctx, req.(*AddCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollaboratorService_SetCollaboratorRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollaboratorRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollaboratorServiceServer).SetCollaboratorRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollaboratorService_SetCollaboratorRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollaboratorServiceServer).SetCollaboratorRole(ctx, req.(*SetCollaboratorRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollaboratorService_RemoveCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCollaboratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollaboratorServiceServer).RemoveCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollaboratorService_RemoveCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollaboratorServiceServer).RemoveCollaborator(ctx, req.(*RemoveCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CollaboratorService_ServiceDesc is the grpc.ServiceDesc for CollaboratorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CollaboratorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitbridge.repo.v1.CollaboratorService",
+	HandlerType: (*CollaboratorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCollaborators",
+			Handler:    _CollaboratorService_ListCollaborators_Handler,
+		},
+		{
+			MethodName: "AddCollaborator",
+			Handler:    _CollaboratorService_AddCollaborator_Handler,
+		},
+		{
+			MethodName: "SetCollaboratorRole",
+			Handler:    _CollaboratorService_SetCollaboratorRole_Handler,
+		},
+		{
+			MethodName: "RemoveCollaborator",
+			Handler:    _CollaboratorService_RemoveCollaborator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "repo.proto",
+}
+
+const (
+	ForkService_ForkRepo_FullMethodName        = "/bitbridge.repo.v1.ForkService/ForkRepo"
+	ForkService_ListForks_FullMethodName       = "/bitbridge.repo.v1.ForkService/ListForks"
+	ForkService_ListForkNetwork_FullMethodName = "/bitbridge.repo.v1.ForkService/ListForkNetwork"
+)
+
+// ForkServiceClient is the client API for ForkService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ForkServiceClient interface {
+	// Forks into the caller's account, or into one of their organizations
+	ForkRepo(ctx context.Context, in *ForkRepoRequest, opts ...grpc.CallOption) (*Repo, error)
+	// Direct forks only
+	ListForks(ctx context.Context, in *ListForksRequest, opts ...grpc.CallOption) (*ListReposResponse, error)
+	// The whole network the repo belongs to, its root included
+	ListForkNetwork(ctx context.Context, in *ListForksRequest, opts ...grpc.CallOption) (*ListReposResponse, error)
+}
+
+type forkServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewForkServiceClient(cc grpc.ClientConnInterface) ForkServiceClient {
+	return &forkServiceClient{cc}
+}
+
+func (c *forkServiceClient) ForkRepo(ctx context.Context, in *ForkRepoRequest, opts ...grpc.CallOption) (*Repo, error) {
+	out := new(Repo)
+	err := c.cc.Invoke(ctx, ForkService_ForkRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forkServiceClient) ListForks(ctx context.Context, in *ListForksRequest, opts ...grpc.CallOption) (*ListReposResponse, error) {
+	out := new(ListReposResponse)
+	err := c.cc.Invoke(ctx, ForkService_ListForks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *forkServiceClient) ListForkNetwork(ctx context.Context, in *ListForksRequest, opts ...grpc.CallOption) (*ListReposResponse, error) {
+	out := new(ListReposResponse)
+	err := c.cc.Invoke(ctx, ForkService_ListForkNetwork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ForkServiceServer is the server API for ForkService service.
+// All implementations must embed UnimplementedForkServiceServer
+// for forward compatibility
+type ForkServiceServer interface {
+	// Forks into the caller's account, or into one of their organizations
+	ForkRepo(context.Context, *ForkRepoRequest) (*Repo, error)
+	// Direct forks only
+	ListForks(context.Context, *ListForksRequest) (*ListReposResponse, error)
+	// The whole network the repo belongs to, its root included
+	ListForkNetwork(context.Context, *ListForksRequest) (*ListReposResponse, error)
+	mustEmbedUnimplementedForkServiceServer()
+}
+
+// UnimplementedForkServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedForkServiceServer struct {
+}
+
+func (UnimplementedForkServiceServer) ForkRepo(context.Context, *ForkRepoRequest) (*Repo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForkRepo not implemented")
+}
+func (UnimplementedForkServiceServer) ListForks(context.Context, *ListForksRequest) (*ListReposResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListForks not implemented")
+}
+func (UnimplementedForkServiceServer) ListForkNetwork(context.Context, *ListForksRequest) (*ListReposResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListForkNetwork not implemented")
+}
+func (UnimplementedForkServiceServer) mustEmbedUnimplementedForkServiceServer() {}
+
+// UnsafeForkServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ForkServiceServer will
+// result in compilation errors.
+type UnsafeForkServiceServer interface {
+	mustEmbedUnimplementedForkServiceServer()
+}
+
+func RegisterForkServiceServer(s grpc.ServiceRegistrar, srv ForkServiceServer) {
+	s.RegisterService(&ForkService_ServiceDesc, srv)
+}
+
+func _ForkService_ForkRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForkRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForkServiceServer).ForkRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForkService_ForkRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForkServiceServer).ForkRepo(ctx, req.(*ForkRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForkService_ListForks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListForksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForkServiceServer).ListForks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForkService_ListForks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForkServiceServer).ListForks(ctx, req.(*ListForksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ForkService_ListForkNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListForksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ForkServiceServer).ListForkNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ForkService_ListForkNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ForkServiceServer).ListForkNetwork(ctx, req.(*ListForksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ForkService_ServiceDesc is the grpc.ServiceDesc for ForkService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ForkService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitbridge.repo.v1.ForkService",
+	HandlerType: (*ForkServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ForkRepo",
+			Handler:    _ForkService_ForkRepo_Handler,
+		},
+		{
+			MethodName: "ListForks",
+			Handler:    _ForkService_ListForks_Handler,
+		},
+		{
+			MethodName: "ListForkNetwork",
+			Handler:    _ForkService_ListForkNetwork_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "repo.proto",
+}
+
+const (
+	SubscriptionService_StarRepo_FullMethodName         = "/bitbridge.repo.v1.SubscriptionService/StarRepo"
+	SubscriptionService_UnstarRepo_FullMethodName       = "/bitbridge.repo.v1.SubscriptionService/UnstarRepo"
+	SubscriptionService_WatchRepo_FullMethodName        = "/bitbridge.repo.v1.SubscriptionService/WatchRepo"
+	SubscriptionService_UnwatchRepo_FullMethodName      = "/bitbridge.repo.v1.SubscriptionService/UnwatchRepo"
+	SubscriptionService_ListStargazers_FullMethodName   = "/bitbridge.repo.v1.SubscriptionService/ListStargazers"
+	SubscriptionService_ListWatchers_FullMethodName     = "/bitbridge.repo.v1.SubscriptionService/ListWatchers"
+	SubscriptionService_ListStarredRepos_FullMethodName = "/bitbridge.repo.v1.SubscriptionService/ListStarredRepos"
+)
+
+// SubscriptionServiceClient is the client API for SubscriptionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SubscriptionServiceClient interface {
+	// Starring a repo again changes nothing
+	StarRepo(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Works on repos the caller can no longer see as well
+	UnstarRepo(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	WatchRepo(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnwatchRepo(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Newest first
+	ListStargazers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error)
+	ListWatchers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error)
+	// Only the starred repos the caller can see, most recently starred first. Pages
+	// come out short by the starred repos the caller cannot see.
+	ListStarredRepos(ctx context.Context, in *ListStarredReposRequest, opts ...grpc.CallOption) (*ListReposResponse, error)
+}
+
+type subscriptionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubscriptionServiceClient(cc grpc.ClientConnInterface) SubscriptionServiceClient {
+	return &subscriptionServiceClient{cc}
+}
+
+func (c *subscriptionServiceClient) StarRepo(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SubscriptionService_StarRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) UnstarRepo(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SubscriptionService_UnstarRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) WatchRepo(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SubscriptionService_WatchRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) UnwatchRepo(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SubscriptionService_UnwatchRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) ListStargazers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error) {
+	out := new(ListSubscribersResponse)
+	err := c.cc.Invoke(ctx, SubscriptionService_ListStargazers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) ListWatchers(ctx context.Context, in *ListSubscribersRequest, opts ...grpc.CallOption) (*ListSubscribersResponse, error) {
+	out := new(ListSubscribersResponse)
+	err := c.cc.Invoke(ctx, SubscriptionService_ListWatchers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) ListStarredRepos(ctx context.Context, in *ListStarredReposRequest, opts ...grpc.CallOption) (*ListReposResponse, error) {
+	out := new(ListReposResponse)
+	err := c.cc.Invoke(ctx, SubscriptionService_ListStarredRepos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubscriptionServiceServer is the server API for SubscriptionService service.
+// All implementations must embed UnimplementedSubscriptionServiceServer
+// for forward compatibility
+type SubscriptionServiceServer interface {
+	// Starring a repo again changes nothing
+	StarRepo(context.Context, *SubscriptionRequest) (*emptypb.Empty, error)
+	// Works on repos the caller can no longer see as well
+	UnstarRepo(context.Context, *SubscriptionRequest) (*emptypb.Empty, error)
+	WatchRepo(context.Context, *SubscriptionRequest) (*emptypb.Empty, error)
+	UnwatchRepo(context.Context, *SubscriptionRequest) (*emptypb.Empty, error)
+	// Newest first
+	ListStargazers(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error)
+	ListWatchers(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error)
+	// Only the starred repos the caller can see, most recently starred first. Pages
+	// come out short by the starred repos the caller cannot see.
+	ListStarredRepos(context.Context, *ListStarredReposRequest) (*ListReposResponse, error)
+	mustEmbedUnimplementedSubscriptionServiceServer()
+}
+
+// UnimplementedSubscriptionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSubscriptionServiceServer struct {
+}
+
+func (UnimplementedSubscriptionServiceServer) StarRepo(context.Context, *SubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StarRepo not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) UnstarRepo(context.Context, *SubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnstarRepo not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) WatchRepo(context.Context, *SubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchRepo not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) UnwatchRepo(context.Context, *SubscriptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwatchRepo not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) ListStargazers(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStargazers not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) ListWatchers(context.Context, *ListSubscribersRequest) (*ListSubscribersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWatchers not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) ListStarredRepos(context.Context, *ListStarredReposRequest) (*ListReposResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStarredRepos not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) mustEmbedUnimplementedSubscriptionServiceServer() {}
+
+// UnsafeSubscriptionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SubscriptionServiceServer will
+// result in compilation errors.
+type UnsafeSubscriptionServiceServer interface {
+	mustEmbedUnimplementedSubscriptionServiceServer()
+}
+
+func RegisterSubscriptionServiceServer(s grpc.ServiceRegistrar, srv SubscriptionServiceServer) {
+	s.RegisterService(&SubscriptionService_ServiceDesc, srv)
+}
+
+func _SubscriptionService_StarRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).StarRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_StarRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).StarRepo(ctx, req.(*SubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_UnstarRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).UnstarRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_UnstarRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).UnstarRepo(ctx, req.(*SubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_WatchRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).WatchRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_WatchRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).WatchRepo(ctx, req.(*SubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_UnwatchRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).UnwatchRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_UnwatchRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).UnwatchRepo(ctx, req.(*SubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_ListStargazers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscribersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).ListStargazers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_ListStargazers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).ListStargazers(ctx, req.(*ListSubscribersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_ListWatchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscribersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).ListWatchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_ListWatchers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).ListWatchers(ctx, req.(*ListSubscribersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_ListStarredRepos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStarredReposRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).ListStarredRepos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_ListStarredRepos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).ListStarredRepos(ctx, req.(*ListStarredReposRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SubscriptionService_ServiceDesc is the grpc.ServiceDesc for SubscriptionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SubscriptionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitbridge.repo.v1.SubscriptionService",
+	HandlerType: (*SubscriptionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StarRepo",
+			Handler:    _SubscriptionService_StarRepo_Handler,
+		},
+		{
+			MethodName: "UnstarRepo",
+			Handler:    _SubscriptionService_UnstarRepo_Handler,
+		},
+		{
+			MethodName: "WatchRepo",
+			Handler:    _SubscriptionService_WatchRepo_Handler,
+		},
+		{
+			MethodName: "UnwatchRepo",
+			Handler:    _SubscriptionService_UnwatchRepo_Handler,
+		},
+		{
+			MethodName: "ListStargazers",
+			Handler:    _SubscriptionService_ListStargazers_Handler,
+		},
+		{
+			MethodName: "ListWatchers",
+			Handler:    _SubscriptionService_ListWatchers_Handler,
+		},
+		{
+			MethodName: "ListStarredRepos",
+			Handler:    _SubscriptionService_ListStarredRepos_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "repo.proto",
+}
+
+const (
+	CountService_ReconcileRepoCounts_FullMethodName = "/bitbridge.repo.v1.CountService/ReconcileRepoCounts"
+)
+
+// CountServiceClient is the client API for CountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CountServiceClient interface {
+	ReconcileRepoCounts(ctx context.Context, in *ReconcileRepoCountsRequest, opts ...grpc.CallOption) (*Repo, error)
+}
+
+type countServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCountServiceClient(cc grpc.ClientConnInterface) CountServiceClient {
+	return &countServiceClient{cc}
+}
+
+func (c *countServiceClient) ReconcileRepoCounts(ctx context.Context, in *ReconcileRepoCountsRequest, opts ...grpc.CallOption) (*Repo, error) {
+	out := new(Repo)
+	err := c.cc.Invoke(ctx, CountService_ReconcileRepoCounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CountServiceServer is the server API for CountService service.
+// All implementations must embed UnimplementedCountServiceServer
+// for forward compatibility
+type CountServiceServer interface {
+	ReconcileRepoCounts(context.Context, *ReconcileRepoCountsRequest) (*Repo, error)
+	mustEmbedUnimplementedCountServiceServer()
+}
+
+// UnimplementedCountServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCountServiceServer struct {
+}
+
+func (UnimplementedCountServiceServer) ReconcileRepoCounts(context.Context, *ReconcileRepoCountsRequest) (*Repo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileRepoCounts not implemented")
+}
+func (UnimplementedCountServiceServer) mustEmbedUnimplementedCountServiceServer() {}
+
+// UnsafeCountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CountServiceServer will
+// result in compilation errors.
+type UnsafeCountServiceServer interface {
+	mustEmbedUnimplementedCountServiceServer()
+}
+
+func RegisterCountServiceServer(s grpc.ServiceRegistrar, srv CountServiceServer) {
+	s.RegisterService(&CountService_ServiceDesc, srv)
+}
+
+func _CountService_ReconcileRepoCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileRepoCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CountServiceServer).ReconcileRepoCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CountService_ReconcileRepoCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CountServiceServer).ReconcileRepoCounts(ctx, req.(*ReconcileRepoCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CountService_ServiceDesc is the grpc.ServiceDesc for CountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitbridge.repo.v1.CountService",
+	HandlerType: (*CountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReconcileRepoCounts",
+			Handler:    _CountService_ReconcileRepoCounts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "repo.proto",
+}
+
+const (
+	OrganizationService_CreateOrganization_FullMethodName = "/bitbridge.repo.v1.OrganizationService/CreateOrganization"
+	OrganizationService_GetOrganization_FullMethodName    = "/bitbridge.repo.v1.OrganizationService/GetOrganization"
+	OrganizationService_SetOrgMember_FullMethodName       = "/bitbridge.repo.v1.OrganizationService/SetOrgMember"
+	OrganizationService_RemoveOrgMember_FullMethodName    = "/bitbridge.repo.v1.OrganizationService/RemoveOrgMember"
+	OrganizationService_ListTeams_FullMethodName          = "/bitbridge.repo.v1.OrganizationService/ListTeams"
+	OrganizationService_CreateTeam_FullMethodName         = "/bitbridge.repo.v1.OrganizationService/CreateTeam"
+	OrganizationService_AddTeamMember_FullMethodName      = "/bitbridge.repo.v1.OrganizationService/AddTeamMember"
+	OrganizationService_RemoveTeamMember_FullMethodName   = "/bitbridge.repo.v1.OrganizationService/RemoveTeamMember"
+	OrganizationService_SetTeamRepo_FullMethodName        = "/bitbridge.repo.v1.OrganizationService/SetTeamRepo"
+	OrganizationService_RemoveTeamRepo_FullMethodName     = "/bitbridge.repo.v1.OrganizationService/RemoveTeamRepo"
+)
+
+// OrganizationServiceClient is the client API for OrganizationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OrganizationServiceClient interface {
+	// The caller becomes the first owner
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*Organization, error)
+	SetOrgMember(ctx context.Context, in *SetOrgMemberRequest, opts ...grpc.CallOption) (*Organization, error)
+	// Members may always leave, except for the last owner
+	RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error)
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*Team, error)
+	AddTeamMember(ctx context.Context, in *TeamMemberRequest, opts ...grpc.CallOption) (*Team, error)
+	RemoveTeamMember(ctx context.Context, in *TeamMemberRequest, opts ...grpc.CallOption) (*Team, error)
+	// Grants are limited to repos of the team's organization and audited on the repo
+	SetTeamRepo(ctx context.Context, in *SetTeamRepoRequest, opts ...grpc.CallOption) (*Team, error)
+	RemoveTeamRepo(ctx context.Context, in *RemoveTeamRepoRequest, opts ...grpc.CallOption) (*Team, error)
+}
+
+type organizationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOrganizationServiceClient(cc grpc.ClientConnInterface) OrganizationServiceClient {
+	return &organizationServiceClient{cc}
+}
+
+func (c *organizationServiceClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, OrganizationService_CreateOrganization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) GetOrganization(ctx context.Context, in *GetOrganizationRequest, opts ...grpc.CallOption) (*Organization, error) {
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, OrganizationService_GetOrganization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) SetOrgMember(ctx context.Context, in *SetOrgMemberRequest, opts ...grpc.CallOption) (*Organization, error) {
+	out := new(Organization)
+	err := c.cc.Invoke(ctx, OrganizationService_SetOrgMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) RemoveOrgMember(ctx context.Context, in *RemoveOrgMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrganizationService_RemoveOrgMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) ListTeams(ctx context.Context, in *ListTeamsRequest, opts ...grpc.CallOption) (*ListTeamsResponse, error) {
+	out := new(ListTeamsResponse)
+	err := c.cc.Invoke(ctx, OrganizationService_ListTeams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*Team, error) {
+	out := new(Team)
+	err := c.cc.Invoke(ctx, OrganizationService_CreateTeam_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) AddTeamMember(ctx context.Context, in *TeamMemberRequest, opts ...grpc.CallOption) (*Team, error) {
+	out := new(Team)
+	err := c.cc.Invoke(ctx, OrganizationService_AddTeamMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) RemoveTeamMember(ctx context.Context, in *TeamMemberRequest, opts ...grpc.CallOption) (*Team, error) {
+	out := new(Team)
+	err := c.cc.Invoke(ctx, OrganizationService_RemoveTeamMember_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) SetTeamRepo(ctx context.Context, in *SetTeamRepoRequest, opts ...grpc.CallOption) (*Team, error) {
+	out := new(Team)
+	err := c.cc.Invoke(ctx, OrganizationService_SetTeamRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *organizationServiceClient) RemoveTeamRepo(ctx context.Context, in *RemoveTeamRepoRequest, opts ...grpc.CallOption) (*Team, error) {
+	out := new(Team)
+	err := c.cc.Invoke(ctx, OrganizationService_RemoveTeamRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OrganizationServiceServer is the server API for OrganizationService service.
+// All implementations must embed UnimplementedOrganizationServiceServer
+// for forward compatibility
+type OrganizationServiceServer interface {
+	// The caller becomes the first owner
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*Organization, error)
+	GetOrganization(context.Context, *GetOrganizationRequest) (*Organization, error)
+	SetOrgMember(context.Context, *SetOrgMemberRequest) (*Organization, error)
+	// Members may always leave, except for the last owner
+	RemoveOrgMember(context.Context, *RemoveOrgMemberRequest) (*emptypb.Empty, error)
+	ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error)
+	CreateTeam(context.Context, *CreateTeamRequest) (*Team, error)
+	AddTeamMember(context.Context, *TeamMemberRequest) (*Team, error)
+	RemoveTeamMember(context.Context, *TeamMemberRequest) (*Team, error)
+	// Grants are limited to repos of the team's organization and audited on the repo
+	SetTeamRepo(context.Context, *SetTeamRepoRequest) (*Team, error)
+	RemoveTeamRepo(context.Context, *RemoveTeamRepoRequest) (*Team, error)
+	mustEmbedUnimplementedOrganizationServiceServer()
+}
+
+// UnimplementedOrganizationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedOrganizationServiceServer struct {
+}
+
+func (UnimplementedOrganizationServiceServer) CreateOrganization(context.Context, *CreateOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganization not implemented")
+}
+func (UnimplementedOrganizationServiceServer) GetOrganization(context.Context, *GetOrganizationRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganization not implemented")
+}
+func (UnimplementedOrganizationServiceServer) SetOrgMember(context.Context, *SetOrgMemberRequest) (*Organization, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrgMember not implemented")
+}
+func (UnimplementedOrganizationServiceServer) RemoveOrgMember(context.Context, *RemoveOrgMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrgMember not implemented")
+}
+func (UnimplementedOrganizationServiceServer) ListTeams(context.Context, *ListTeamsRequest) (*ListTeamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTeams not implemented")
+}
+func (UnimplementedOrganizationServiceServer) CreateTeam(context.Context, *CreateTeamRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeam not implemented")
+}
+func (UnimplementedOrganizationServiceServer) AddTeamMember(context.Context, *TeamMemberRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTeamMember not implemented")
+}
+func (UnimplementedOrganizationServiceServer) RemoveTeamMember(context.Context, *TeamMemberRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamMember not implemented")
+}
+func (UnimplementedOrganizationServiceServer) SetTeamRepo(context.Context, *SetTeamRepoRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTeamRepo not implemented")
+}
+func (UnimplementedOrganizationServiceServer) RemoveTeamRepo(context.Context, *RemoveTeamRepoRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTeamRepo not implemented")
+}
+func (UnimplementedOrganizationServiceServer) mustEmbedUnimplementedOrganizationServiceServer() {}
+
+// UnsafeOrganizationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OrganizationServiceServer will
+// result in compilation errors.
+type UnsafeOrganizationServiceServer interface {
+	mustEmbedUnimplementedOrganizationServiceServer()
+}
+
+func RegisterOrganizationServiceServer(s grpc.ServiceRegistrar, srv OrganizationServiceServer) {
+	s.RegisterService(&OrganizationService_ServiceDesc, srv)
+}
+
+func _OrganizationService_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_CreateOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_GetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).GetOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_GetOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).GetOrganization(ctx, req.(*GetOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_SetOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrgMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).SetOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_SetOrgMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).SetOrgMember(ctx, req.(*SetOrgMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_RemoveOrgMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrgMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).RemoveOrgMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_RemoveOrgMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).RemoveOrgMember(ctx, req.(*RemoveOrgMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_ListTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).ListTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_ListTeams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).ListTeams(ctx, req.(*ListTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_CreateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_AddTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).AddTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_AddTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).AddTeamMember(ctx, req.(*TeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_RemoveTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).RemoveTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_RemoveTeamMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).RemoveTeamMember(ctx, req.(*TeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_SetTeamRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTeamRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).SetTeamRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_SetTeamRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).SetTeamRepo(ctx, req.(*SetTeamRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrganizationService_RemoveTeamRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrganizationServiceServer).RemoveTeamRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrganizationService_RemoveTeamRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrganizationServiceServer).RemoveTeamRepo(ctx, req.(*RemoveTeamRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OrganizationService_ServiceDesc is the grpc.ServiceDesc for OrganizationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OrganizationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bitbridge.repo.v1.OrganizationService",
+	HandlerType: (*OrganizationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOrganization",
+			Handler:    _OrganizationService_CreateOrganization_Handler,
+		},
+		{
+			MethodName: "GetOrganization",
+			Handler:    _OrganizationService_GetOrganization_Handler,
+		},
+		{
+			MethodName: "SetOrgMember",
+			Handler:    _OrganizationService_SetOrgMember_Handler,
+		},
+		{
+			MethodName: "RemoveOrgMember",
+			Handler:    _OrganizationService_RemoveOrgMember_Handler,
+		},
+		{
+			MethodName: "ListTeams",
+			Handler:    _OrganizationService_ListTeams_Handler,
+		},
+		{
+			MethodName: "CreateTeam",
+			Handler:    _OrganizationService_CreateTeam_Handler,
+		},
+		{
+			MethodName: "AddTeamMember",
+			Handler:    _OrganizationService_AddTeamMember_Handler,
+		},
+		{
+			MethodName: "RemoveTeamMember",
+			Handler:    _OrganizationService_RemoveTeamMember_Handler,
+		},
+		{
+			MethodName: "SetTeamRepo",
+			Handler:    _OrganizationService_SetTeamRepo_Handler,
+		},
+		{
+			MethodName: "RemoveTeamRepo",
+			Handler:    _OrganizationService_RemoveTeamRepo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "repo.proto",
+}